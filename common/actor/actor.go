@@ -0,0 +1,114 @@
+package actor
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+)
+
+const (
+	// HTTPHeader is the request header the client identifies itself with
+	HTTPHeader = "X-Actor"
+	// MetadataKey is the grpc metadata key the actor is passed between services with
+	MetadataKey = "x-actor"
+)
+
+type ctxKey struct{}
+
+// NewContext returns a copy of the context carrying the actor name
+func NewContext(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, name)
+}
+
+// FromContext returns the actor name stored in the context or empty string
+func FromContext(ctx context.Context) string {
+	name, _ := ctx.Value(ctxKey{}).(string)
+	return name
+}
+
+// HTTPMiddleware stores the actor from the request header in the request context
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if name := r.Header.Get(HTTPHeader); name != "" {
+			r = r.WithContext(NewContext(r.Context(), name))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// UnaryClientInterceptor passes the actor from the context to the grpc server in the outgoing metadata
+func UnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+}
+
+// UnaryServerInterceptor stores the actor from the incoming metadata in the handler context
+func UnaryServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return handler(incomingContext(ctx), req)
+}
+
+// StreamClientInterceptor passes the actor from the context to the grpc server in the outgoing metadata of the stream
+func StreamClientInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+}
+
+// StreamServerInterceptor stores the actor from the incoming metadata in the stream context
+func StreamServerInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &serverStream{ServerStream: ss, ctx: incomingContext(ss.Context())})
+}
+
+// serverStream is the server stream with the context carrying the actor
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func outgoingContext(ctx context.Context) context.Context {
+	if name := FromContext(ctx); name != "" {
+		return metadata.AppendToOutgoingContext(ctx, MetadataKey, name)
+	}
+
+	return ctx
+}
+
+func incomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	if values := md.Get(MetadataKey); len(values) > 0 && values[0] != "" {
+		return NewContext(ctx, values[0])
+	}
+
+	return ctx
+}
@@ -10,6 +10,13 @@ import (
 	"strings"
 )
 
+// Database drivers
+const (
+	DriverPostgres = "postgres"
+	DriverMemory   = "memory"
+	DriverFile     = "file"
+)
+
 // TSSTConfig represents typical structure of service config file
 type TSSTConfig struct {
 	Services  map[string]ServiceConfig  `json:"services"`
@@ -25,8 +32,11 @@ type ServiceConfig struct {
 	Services []string         `json:"services"`
 }
 
-// DatabaseConfig represents typical structure of database config
+// DatabaseConfig represents typical structure of database config,
+// driver is one of postgres, memory or file and defaults to postgres
 type DatabaseConfig struct {
+	Driver   string            `json:"driver"`
+	Path     string            `json:"path"`
 	Address  string            `json:"address"`
 	Dbname   string            `json:"dbname"`
 	Username string            `json:"username"`
@@ -80,16 +90,30 @@ func (mgr *ConfigManager) Preload() (*TSSTConfig, error) {
 	return &tsCfg, nil
 }
 
-// GetPostgresDSN builds postgres connection string from config values
-func (mgr *ConfigManager) GetPostgresDSN() (string, error) {
-	pgConf, ok := mgr.Config.Databases["postgres"]
+// GetPostgresDSN builds postgres connection string from the named database config values
+func (mgr *ConfigManager) GetPostgresDSN(name string) (string, error) {
+	pgConf, ok := mgr.Config.Databases[name]
 	if !ok {
-		return "", errors.New("missing postgres config")
+		return "", errors.New(fmt.Sprintf("missing %s config", name))
 	}
 
 	return fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", pgConf.Username, pgConf.Password, pgConf.Address, pgConf.Dbname), nil
 }
 
+// GetDatabaseConfig returns the named database config with the driver defaulted to postgres
+func (mgr *ConfigManager) GetDatabaseConfig(name string) (*DatabaseConfig, error) {
+	cfg, ok := mgr.Config.Databases[name]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("%s database config is missing", name))
+	}
+
+	if cfg.Driver == "" {
+		cfg.Driver = DriverPostgres
+	}
+
+	return &cfg, nil
+}
+
 func (mgr *ConfigManager) GetServiceConfig(name string) (*ServiceConfig, error) {
 	cfg, ok := mgr.Config.Services[name]
 	if !ok {
@@ -99,9 +123,9 @@ func (mgr *ConfigManager) GetServiceConfig(name string) (*ServiceConfig, error)
 	return &cfg, nil
 }
 
-// OpenDB opens connection to postgres
-func (mgr *ConfigManager) OpenDB() (*gorm.DB, error) {
-	dsn, err := mgr.GetPostgresDSN()
+// OpenDB opens connection to postgres described by the named database config
+func (mgr *ConfigManager) OpenDB(name string) (*gorm.DB, error) {
+	dsn, err := mgr.GetPostgresDSN(name)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,418 @@
+// Package csvparser reads ports from csv files, the rows come as entries of jsonparser.Stream,
+// so they go through the same pipeline as the json files
+package csvparser
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/kreyyser/transshipment/common/jsonparser"
+	"github.com/kreyyser/transshipment/common/locode"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Format is the kind of the csv file
+type Format string
+
+const (
+	// FormatTable is a csv file with the header row naming the port fields of its columns
+	FormatTable Format = "csv"
+	// FormatUNLOCODE is the UN/LOCODE code list of UNECE, only the ports of it are read
+	FormatUNLOCODE Format = "unlocode"
+)
+
+// DefaultKeyColumn is the column of the table the entry keys are read from
+const DefaultKeyColumn = "slug"
+
+// listSeparator separates the values of the list fields like alias or unlocks in a single column
+const listSeparator = ";"
+
+// fields are the port fields the columns of the table are mapped to by their names
+var fields = map[string]string{
+	"slug":        "slug",
+	"name":        "name",
+	"port_name":   "name",
+	"city":        "city",
+	"province":    "province",
+	"subdivision": "province",
+	"country":     "country",
+	"alias":       "alias",
+	"aliases":     "alias",
+	"regions":     "regions",
+	"coordinates": "coordinates",
+	"latitude":    "latitude",
+	"lat":         "latitude",
+	"longitude":   "longitude",
+	"lng":         "longitude",
+	"lon":         "longitude",
+	"timezone":    "timezone",
+	"unlocks":     "unlocks",
+	"unlocode":    "unlocks",
+	"locode":      "unlocks",
+	"code":        "code",
+}
+
+// listFields are the port fields holding lists
+var listFields = map[string]bool{"alias": true, "regions": true, "unlocks": true}
+
+// FieldError tells why the field of the row could not be read
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Reason
+	}
+
+	return fmt.Sprintf("%s: %s", e.Field, e.Reason)
+}
+
+type Parser struct {
+	format    Format
+	newValue  jsonparser.NewValueFunc
+	keyColumn string
+	comma     rune
+}
+
+// New makes a parser of the csv files of the format, the rows are decoded into what newValue makes
+// by the json names of the port fields, like name or coordinates as [longitude, latitude]
+func New(format Format, newValue jsonparser.NewValueFunc) Parser {
+	return Parser{
+		format:    format,
+		newValue:  newValue,
+		keyColumn: DefaultKeyColumn,
+		comma:     ',',
+	}
+}
+
+// KeyColumn returns the parser which reads the entry keys from the column of the table
+func (p Parser) KeyColumn(name string) Parser {
+	p.keyColumn = normalize(name)
+
+	return p
+}
+
+// Comma returns the parser of the files with the values separated by the rune
+func (p Parser) Comma(r rune) Parser {
+	p.comma = r
+
+	return p
+}
+
+// Source reads the rows of the file as entries keyed by the slug, a row which can't be read fails only its own entry
+func (p Parser) Source(file io.Reader) jsonparser.Source {
+	return func(ctx context.Context, add func(jsonparser.Entry) error) error {
+		rows := &rowReader{r: bufio.NewReader(file), comma: p.comma}
+
+		switch p.format {
+		case FormatTable:
+			return p.readTable(ctx, rows, add)
+		case FormatUNLOCODE:
+			return p.readUNLOCODE(ctx, rows, add)
+		default:
+			return fmt.Errorf("unknown format %q", p.format)
+		}
+	}
+}
+
+// readTable reads the rows of the table by its header
+func (p Parser) readTable(ctx context.Context, rows *rowReader, add func(jsonparser.Entry) error) error {
+	header, _, err := rows.next()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading csv header. err: %v", err)
+	}
+
+	columns := make([]string, len(header))
+	key := -1
+	for i, name := range header {
+		name = normalize(name)
+		if name == p.keyColumn {
+			key = i
+		}
+		columns[i] = fields[name]
+	}
+
+	if key < 0 {
+		return fmt.Errorf("error reading csv header. %s column is missing", p.keyColumn)
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		record, offset, err := rows.next()
+		if err == io.EOF {
+			return nil
+		}
+
+		entry := jsonparser.Entry{Offset: offset, Value: p.newValue()}
+		switch err.(type) {
+		case nil:
+			if key < len(record) {
+				entry.Key = strings.TrimSpace(record[key])
+			}
+			entry.Err = p.decode(tableFields(columns, record), entry)
+		case *FieldError:
+			entry.Err = err
+		default:
+			return err
+		}
+
+		if err := add(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// readUNLOCODE reads the ports of the code list, the other locations and the removed entries are skipped
+func (p Parser) readUNLOCODE(ctx context.Context, rows *rowReader, add func(jsonparser.Entry) error) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		record, offset, err := rows.next()
+		if err == io.EOF {
+			return nil
+		}
+
+		entry := jsonparser.Entry{Offset: offset, Value: p.newValue()}
+		switch err.(type) {
+		case nil:
+			loc, err := locode.FromRecord(record)
+			if err != nil {
+				entry.Err = err
+				break
+			}
+
+			if loc.IsCountry() || !loc.IsPort() || loc.Removed() {
+				continue
+			}
+
+			entry.Key = loc.Code()
+			entry.Err = p.decode(locationFields(loc), entry)
+		case *FieldError:
+			entry.Err = err
+		default:
+			return err
+		}
+
+		if err := add(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// decode decodes the port fields of the row into the entry value
+func (p Parser) decode(values map[string]interface{}, entry jsonparser.Entry) error {
+	coordinates, err := rowCoordinates(values)
+	if err != nil {
+		return err
+	}
+	if coordinates != nil {
+		values["coordinates"] = coordinates
+	}
+	delete(values, "latitude")
+	delete(values, "longitude")
+
+	if entry.Key != "" {
+		values["slug"] = entry.Key
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, entry.Value)
+}
+
+// tableFields maps the row values to the port fields of their columns, empty values are left out
+func tableFields(columns, record []string) map[string]interface{} {
+	values := make(map[string]interface{}, len(columns))
+	for i, value := range record {
+		value = strings.TrimSpace(value)
+		if i >= len(columns) || columns[i] == "" || value == "" {
+			continue
+		}
+
+		if listFields[columns[i]] {
+			values[columns[i]] = splitList(value)
+			continue
+		}
+		values[columns[i]] = value
+	}
+
+	return values
+}
+
+// locationFields maps the location of the code list to the port fields
+func locationFields(loc locode.Location) map[string]interface{} {
+	values := map[string]interface{}{
+		"name":    loc.Name,
+		"country": loc.CountryName(),
+		"unlocks": []string{loc.Code()},
+	}
+
+	if loc.NameWoDiacritics != "" && loc.NameWoDiacritics != loc.Name {
+		values["alias"] = []string{loc.NameWoDiacritics}
+	}
+
+	if loc.Subdivision != "" {
+		values["province"] = loc.Subdivision
+	}
+
+	if loc.Coordinates != "" {
+		values["coordinates"] = loc.Coordinates
+	}
+
+	return values
+}
+
+// rowCoordinates reads the coordinates of the row as [longitude, latitude], either from the coordinates column
+// in degrees and minutes like 5155N 00430E or as decimal longitude and latitude separated by listSeparator,
+// or from the latitude and longitude columns
+func rowCoordinates(values map[string]interface{}) ([]float64, error) {
+	if value, ok := values["coordinates"].(string); ok {
+		if lat, lng, err := locode.ParseCoordinates(value); err == nil {
+			return []float64{lng, lat}, nil
+		}
+
+		parts := splitList(value)
+		if len(parts) != 2 {
+			return nil, &FieldError{Field: "coordinates", Reason: "value must be like 5155N 00430E or 4.5;51.9"}
+		}
+
+		lng, lngErr := strconv.ParseFloat(parts[0], 64)
+		lat, latErr := strconv.ParseFloat(parts[1], 64)
+		if lngErr != nil || latErr != nil {
+			return nil, &FieldError{Field: "coordinates", Reason: "value must be like 5155N 00430E or 4.5;51.9"}
+		}
+
+		return []float64{lng, lat}, nil
+	}
+
+	latValue, hasLat := values["latitude"].(string)
+	lngValue, hasLng := values["longitude"].(string)
+	if !hasLat && !hasLng {
+		return nil, nil
+	}
+
+	lat, err := degrees(latValue)
+	if err != nil {
+		return nil, &FieldError{Field: "latitude", Reason: err.Error()}
+	}
+
+	lng, err := degrees(lngValue)
+	if err != nil {
+		return nil, &FieldError{Field: "longitude", Reason: err.Error()}
+	}
+
+	return []float64{lng, lat}, nil
+}
+
+// degrees reads the decimal degrees or degrees and minutes like 5155N
+func degrees(value string) (float64, error) {
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f, nil
+	}
+
+	return locode.ParseDegrees(value)
+}
+
+// splitList splits the list values of the column
+func splitList(value string) []string {
+	var list []string
+	for _, v := range strings.Split(value, listSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
+// normalize makes the column names like Port Name and port_name the same
+func normalize(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
+}
+
+// rowReader reads the csv rows one by one keeping their byte offsets in the file,
+// so a malformed row fails on its own and the next one is read still
+type rowReader struct {
+	r      *bufio.Reader
+	comma  rune
+	offset int64
+}
+
+// next reads the next row which is not empty with its offset, files in Latin-1 like the code list are read as well
+func (rr *rowReader) next() ([]string, int64, error) {
+	for {
+		start := rr.offset
+
+		// a quoted value may hold line breaks, the row goes on until the quotes are closed
+		var row []byte
+		for {
+			line, err := rr.r.ReadBytes('\n')
+			rr.offset += int64(len(line))
+			row = append(row, line...)
+
+			if err == io.EOF {
+				if len(row) == 0 {
+					return nil, start, io.EOF
+				}
+				break
+			}
+			if err != nil {
+				return nil, start, err
+			}
+
+			if bytes.Count(row, []byte{'"'})%2 == 0 {
+				break
+			}
+		}
+
+		if len(bytes.TrimSpace(row)) == 0 {
+			continue
+		}
+
+		if !utf8.Valid(row) {
+			row = latin1(row)
+		}
+
+		cr := csv.NewReader(bytes.NewReader(row))
+		cr.Comma = rr.comma
+		cr.FieldsPerRecord = -1
+		record, err := cr.Read()
+		if perr, ok := err.(*csv.ParseError); ok {
+			return nil, start, &FieldError{Reason: perr.Err.Error()}
+		}
+		if err != nil {
+			return nil, start, err
+		}
+
+		return record, start, nil
+	}
+}
+
+// latin1 turns the Latin-1 text into utf-8
+func latin1(b []byte) []byte {
+	runes := make([]rune, 0, len(b))
+	for _, c := range b {
+		runes = append(runes, rune(c))
+	}
+
+	return []byte(string(runes))
+}
@@ -0,0 +1,127 @@
+package csvparser
+
+import (
+	"bytes"
+	"context"
+	"github.com/kreyyser/transshipment/common/jsonparser"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type port struct {
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	Province    string    `json:"province"`
+	Country     string    `json:"country"`
+	Alias       []string  `json:"alias"`
+	Coordinates []float64 `json:"coordinates"`
+	Unlocks     []string  `json:"unlocks"`
+}
+
+func newPort() interface{} {
+	return new(port)
+}
+
+func TestParser_Source(t *testing.T) {
+	cases := []struct {
+		name    string
+		format  Format
+		file    string
+		ports   []port
+		offsets []int64
+		failed  []string
+		err     bool
+	}{
+		{
+			name:   "table",
+			format: FormatTable,
+			file: "Slug,Port Name,Country,Alias,Latitude,Longitude\n" +
+				"NLRTM,Rotterdam,Netherlands,\"R'dam;Port of\nRotterdam\",51.9,4.5\n" +
+				"\n" +
+				"DEHAM,Hamburg,Germany,,north,9.9\n" +
+				"BEANR,Antwerp,Belgium,,5113N,00425E\n",
+			ports: []port{
+				{
+					Slug: "NLRTM", Name: "Rotterdam", Country: "Netherlands",
+					Alias: []string{"R'dam", "Port of\nRotterdam"}, Coordinates: []float64{4.5, 51.9},
+				},
+				{
+					Slug: "BEANR", Name: "Antwerp", Country: "Belgium",
+					Coordinates: []float64{4 + 25.0/60, 51 + 13.0/60},
+				},
+			},
+			offsets: []int64{48, 112, 145},
+			failed:  []string{"DEHAM"},
+		},
+		{
+			name:   "missing slug column",
+			format: FormatTable,
+			file:   "name,country\nRotterdam,Netherlands\n",
+			err:    true,
+		},
+		{
+			name:   "unlocode",
+			format: FormatUNLOCODE,
+			file: ",\"NL\",\"\",\".NETHERLANDS\",\".NETHERLANDS\",\"\",\"\",\"\",\"\",\"\",\"\",\"\"\n" +
+				",\"NL\",\"RTM\",\"Rotterdam\",\"Rotterdam\",\"ZH\",\"12345---\",\"AI\",\"0401\",\"\",\"5155N 00430E\",\"\"\n" +
+				",\"NL\",\"AMS\",\"Amsterdam\",\"Amsterdam\",\"NH\",\"-2345---\",\"AI\",\"0401\",\"\",\"5223N 00454E\",\"\"\n" +
+				"X,\"NL\",\"OLD\",\"Old port\",\"Old port\",\"\",\"1-------\",\"XX\",\"0401\",\"\",\"\",\"\"\n" +
+				",\"FR\",\"LEH\",\"Le Havre\",\"Le Havre\",\"76\",\"1-3-----\",\"AI\",\"0401\",\"\",\"4930N 00006E\",\"\"\n" +
+				",\"DE\",\"HAM\",\"Hamburg\",\"Hamburg\",\"HH\",\"12345---\",\"AI\",\"0401\",\"\",\"5333X 00959E\",\"\"\n",
+			ports: []port{
+				{
+					Slug: "NLRTM", Name: "Rotterdam", Province: "ZH", Country: "Netherlands",
+					Coordinates: []float64{4.5, 51 + 55.0/60}, Unlocks: []string{"NLRTM"},
+				},
+				{
+					Slug: "FRLEH", Name: "Le Havre", Province: "76", Country: "France",
+					Coordinates: []float64{0.1, 49.5}, Unlocks: []string{"FRLEH"},
+				},
+			},
+			failed: []string{"DEHAM"},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			src := New(test.format, newPort).Source(bytes.NewBufferString(test.file))
+			stream := jsonparser.New(16, 2, newPort).Stream(context.Background(), src)
+			defer stream.Close()
+
+			var (
+				ports   []port
+				offsets []int64
+				failed  []string
+			)
+			for entries, ok := stream.Next(); ok; entries, ok = stream.Next() {
+				for _, entry := range entries {
+					offsets = append(offsets, entry.Offset)
+					if entry.Err != nil {
+						failed = append(failed, entry.Key)
+						continue
+					}
+					ports = append(ports, *entry.Value.(*port))
+				}
+			}
+
+			if test.err {
+				r.Error(stream.Err())
+				return
+			}
+
+			r.NoError(stream.Err())
+			r.Equal(test.failed, failed)
+			r.Len(ports, len(test.ports))
+			for i, p := range test.ports {
+				r.InDeltaSlice(p.Coordinates, ports[i].Coordinates, 1e-9)
+				ports[i].Coordinates = p.Coordinates
+				r.Equal(p, ports[i])
+			}
+			if test.offsets != nil {
+				r.Equal(test.offsets, offsets)
+			}
+		})
+	}
+}
@@ -1,109 +1,580 @@
 package jsonparser
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 )
 
+// Entry is a single key of the parsed json object with its value decoded into the type the parser makes,
+// a value which does not fit the type fails only its own entry
+type Entry struct {
+	Key string
+	// Offset is the byte offset of the key in the file, or of the object holding the key
+	Offset int64
+	// Value is the value made by the parser's NewValueFunc, json.RawMessage when the parser has none
+	Value interface{}
+	// Err is set when the value could not be decoded into the type, the rest of the file is parsed still
+	Err error
+}
+
+// NewValueFunc makes a new value, a pointer, every entry is decoded into
+type NewValueFunc func() interface{}
+
+// Format is the shape of the parsed file
+type Format string
+
+const (
+	// FormatAuto detects the format from the beginning of the file
+	FormatAuto Format = ""
+	// FormatObject is a json object of the values keyed by their keys
+	FormatObject Format = "object"
+	// FormatArray is a json array of the objects, the key is a property of the object
+	FormatArray Format = "array"
+	// FormatNDJSON is the objects one per line, the key is a property of the object
+	FormatNDJSON Format = "ndjson"
+	// FormatGeoJSON is a GeoJSON FeatureCollection of Point features, the values are the properties of the features
+	// with the point as their coordinates, the key is a property or the id of the feature
+	FormatGeoJSON Format = "geojson"
+)
+
+// Formats are the formats the parser reads
+var Formats = []Format{FormatObject, FormatArray, FormatNDJSON, FormatGeoJSON}
+
 type Parser struct {
-	buffSize  int
-	chunkSize int
+	buffSize    int
+	chunkSize   int
+	backlog     int
+	newValue    NewValueFunc
+	format      Format
+	keyProperty string
 }
 
-func New(buff, chunk int) Parser{
+const (
+	// defaultBacklog is the number of parsed chunks waiting for the consumer before the parsing pauses
+	defaultBacklog = 2
+	// detectSize is the number of bytes at the beginning of the file the format is detected from
+	detectSize = 4 << 10
+	// DefaultKeyProperty is the property of the objects the key is read from when the file is not a keyed object
+	DefaultKeyProperty = "slug"
+)
+
+// New makes a parser which reads the file by buff bytes and sends chunk entries at once,
+// the values are decoded into what newValue makes or kept raw when it is nil
+func New(buff, chunk int, newValue NewValueFunc) Parser {
+	if newValue == nil {
+		newValue = func() interface{} { return new(json.RawMessage) }
+	}
+
 	return Parser{
-		buffSize:  buff,
-		chunkSize: chunk,
+		buffSize:    buff,
+		chunkSize:   chunk,
+		backlog:     defaultBacklog,
+		newValue:    newValue,
+		keyProperty: DefaultKeyProperty,
 	}
 }
 
-func(p Parser) ParseFile(file io.Reader) ( <-chan map[string]interface{}, <-chan error, error) {
-	errChan := make(chan error)
-	pr, pw := io.Pipe()
+// Format returns the parser which reads the files of the format, FormatAuto detects it
+func (p Parser) Format(format Format) Parser {
+	p.format = format
+
+	return p
+}
+
+// KeyProperty returns the parser which reads the keys of the entries from the property of the objects,
+// it is not used for FormatObject where the object keys are the entry keys
+func (p Parser) KeyProperty(name string) Parser {
+	p.keyProperty = name
+
+	return p
+}
+
+// Backlog returns the parser which keeps up to n parsed chunks waiting for the consumer
+func (p Parser) Backlog(n int) Parser {
+	p.backlog = n
+
+	return p
+}
+
+// Stream is the file being parsed, the parsing stops at the end of the file, on the first error,
+// once the context is done or the stream is closed
+type Stream struct {
+	chunks <-chan []Entry
+	cancel context.CancelFunc
+	err    error
+}
+
+// Source reads the entries of a file and adds them in the file order, it stops once add fails
+type Source func(ctx context.Context, add func(Entry) error) error
+
+// Parse starts parsing the file, its entries come in chunks keeping the file order
+func (p Parser) Parse(ctx context.Context, file io.Reader) *Stream {
+	return p.Stream(ctx, func(ctx context.Context, add func(Entry) error) error {
+		return p.parse(ctx, file, add)
+	})
+}
+
+// Stream starts reading the entries from the source, they come in chunks the same way the parsed json ones do
+func (p Parser) Stream(ctx context.Context, src Source) *Stream {
+	ctx, cancel := context.WithCancel(ctx)
+	chunks := make(chan []Entry, p.backlog)
+	s := &Stream{chunks: chunks, cancel: cancel}
+
 	go func() {
-		// close the writer, so the reader knows there's no more data
-		defer func() {
-			if err := pw.Close(); err != nil {
-				fmt.Printf("error closing pipe writer. err: %v", err)
-			}
-		}()
-
-		buf := make([]byte, int64(p.buffSize))
-		defer func() {
-			buf = nil
-		}()
-		for {
-			chunk, err := file.Read(buf)
-			if err != nil && err != io.EOF {
-				errChan <- err
-				fmt.Printf("error reading file. err: %v", err)
-				break
-			}
-			if chunk == 0 {
-				break
-			}
+		defer close(chunks)
 
-			if _, err := pw.Write(buf[:chunk]); err != nil{
-				return
-			}
+		c := &chunker{ctx: ctx, chunks: chunks, chunk: make([]Entry, 0, p.chunkSize)}
+		err := src(ctx, c.add)
+		if err == nil {
+			err = c.flush()
 		}
+		s.err = unwrapReadErr(err)
 	}()
 
-	dec := json.NewDecoder(pr)
+	return s
+}
+
+// Next waits for the next chunk of entries, it returns false once the parsing stopped
+func (s *Stream) Next() ([]Entry, bool) {
+	chunk, ok := <-s.chunks
+
+	return chunk, ok
+}
+
+// Err is the error the parsing stopped with, it is set once Next returned false
+func (s *Stream) Err() error {
+	return s.err
+}
+
+// Close stops the parsing and waits until it's done, it is safe to call at any time and more than once
+func (s *Stream) Close() {
+	s.cancel()
+	for range s.chunks {
+	}
+}
+
+// parse reads the entries of the file and sends them in chunks
+func (p Parser) parse(ctx context.Context, file io.Reader, add func(Entry) error) error {
+	// the format is detected from what the buffer holds, so it is never too small for that
+	size := p.buffSize
+	if size < detectSize {
+		size = detectSize
+	}
+	r := bufio.NewReaderSize(readErrReader{ctx: ctx, r: file}, size)
+
+	format := p.format
+	if format == FormatAuto {
+		format = detectFormat(r)
+	}
+
+	dec := json.NewDecoder(r)
+	switch format {
+	case FormatObject:
+		return p.parseObject(dec, add)
+	case FormatArray:
+		return p.parseArray(dec, add)
+	case FormatNDJSON:
+		return p.parseNDJSON(dec, add)
+	case FormatGeoJSON:
+		return p.parseGeoJSON(dec, add)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// parseObject reads the entries of the json object keyed by the entry keys
+func (p Parser) parseObject(dec *json.Decoder, add func(Entry) error) error {
 	// read open bracket
-	if _, err := dec.Token(); err != nil {
-		fmt.Printf("error reading open json bracket. err: %v", err)
-		return nil, nil, err
+	if err := expectDelim(dec, '{', "file is not a json object"); err != nil {
+		return err
 	}
 
-	readChan := make(chan map[string]interface{})
-	go func() {
-		chunk := make(map[string]interface{}, p.chunkSize)
-		var parseErr error
-		// while the map contains keys
-		for dec.More() {
-			var m map[string]interface{}
-
-			// read the key
-			key, err := dec.Token()
-			if err != nil {
-				parseErr = err
-				fmt.Printf("error reading struct key. err: %v", err)
-				errChan <- err
-				break
+	// while the map contains keys
+	for dec.More() {
+		// read the key
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		// the decoder is right past the key, quoted key length takes it back to the key start
+		offset := dec.InputOffset()
+		if quoted, err := json.Marshal(key); err == nil {
+			offset -= int64(len(quoted))
+		}
+
+		strKey, ok := key.(string)
+		if !ok {
+			return errors.New("error reading struct key. key is not a string")
+		}
+
+		// read the data straight into the value, a value of a wrong type does not break the stream
+		entry := Entry{Key: strKey, Offset: offset, Value: p.newValue()}
+		if err = dec.Decode(entry.Value); err != nil {
+			if broken(err) {
+				return err
 			}
+			entry.Err = err
+		}
+
+		if err := add(entry); err != nil {
+			return err
+		}
+	}
+
+	// read close bracket, a file cut short ends before it
+	return expectDelim(dec, '}', "json object is not closed")
+}
+
+// parseArray reads the entries of the json array of objects
+func (p Parser) parseArray(dec *json.Decoder, add func(Entry) error) error {
+	if err := expectDelim(dec, '[', "file is not a json array"); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		if err := p.addObject(dec, add); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']', "json array is not closed")
+}
+
+// parseNDJSON reads the entries of the objects one after another
+func (p Parser) parseNDJSON(dec *json.Decoder, add func(Entry) error) error {
+	for dec.More() {
+		if err := p.addObject(dec, add); err != nil {
+			return err
+		}
+	}
+
+	// More stops at the end of the file, on errors and on closing brackets, only the first is fine
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errors.New("error reading ndjson. unexpected closing bracket")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// parseGeoJSON reads the entries of the features of the GeoJSON FeatureCollection,
+// the members other than features are skipped
+func (p Parser) parseGeoJSON(dec *json.Decoder, add func(Entry) error) error {
+	if err := expectDelim(dec, '{', "file is not a json object"); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
 
-			strKey, ok := key.(string)
-			if !ok {
-				err = errors.New("error reading struct key. key is not a string")
-				parseErr = err
-				errChan <- err
-				break
+		if key != "features" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
 			}
+			continue
+		}
+
+		if err := expectDelim(dec, '[', "features are not a json array"); err != nil {
+			return err
+		}
 
-			// read the data
-			if err = dec.Decode(&m); err != nil {
-				parseErr = err
-				fmt.Printf("error reading struct key value. err: %v", err)
-				errChan <- err
-				break
+		for dec.More() {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
 			}
 
-			chunk[strKey] = m
-			if len(chunk) == p.chunkSize {
-				readChan <- chunk
-				chunk = make(map[string]interface{}, p.chunkSize)
+			entry := Entry{Offset: dec.InputOffset() - int64(len(raw)), Value: p.newValue()}
+			entry.Key, entry.Err = p.decodeFeature(raw, entry.Value)
+			if err := add(entry); err != nil {
+				return err
 			}
 		}
 
-		if parseErr != nil {
-			return
+		if err := expectDelim(dec, ']', "features are not closed"); err != nil {
+			return err
 		}
+	}
 
-		close(readChan)
-	}()
+	return expectDelim(dec, '}', "json object is not closed")
+}
+
+// addObject reads the next object and adds its entry
+func (p Parser) addObject(dec *json.Decoder, add func(Entry) error) error {
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+
+	entry := Entry{Offset: dec.InputOffset() - int64(len(raw)), Value: p.newValue()}
+	entry.Key, entry.Err = p.decodeObject(raw, entry.Value)
+
+	return add(entry)
+}
+
+// decodeObject decodes the object into the value and returns its key
+func (p Parser) decodeObject(raw json.RawMessage, value interface{}) (string, error) {
+	key, err := property(raw, p.keyProperty)
+	if err != nil {
+		return "", err
+	}
+
+	return key, json.Unmarshal(raw, value)
+}
+
+// geoFeature is the GeoJSON Feature
+type geoFeature struct {
+	Type     string      `json:"type"`
+	ID       interface{} `json:"id"`
+	Geometry *struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+	Properties json.RawMessage `json:"properties"`
+}
+
+// decodeFeature decodes the properties of the Point feature with the point as their coordinates
+// into the value and returns its key, the feature without geometry keeps the properties as they are
+func (p Parser) decodeFeature(raw json.RawMessage, value interface{}) (string, error) {
+	var feature geoFeature
+	if err := json.Unmarshal(raw, &feature); err != nil {
+		return "", err
+	}
+
+	if feature.Type != "Feature" {
+		return "", fmt.Errorf("type must be Feature, got %q", feature.Type)
+	}
+
+	if feature.Geometry != nil && feature.Geometry.Type != "Point" {
+		return "", errors.New("geometry must be a Point")
+	}
+
+	if len(feature.Properties) == 0 || string(feature.Properties) == "null" {
+		feature.Properties = json.RawMessage("{}")
+	}
+
+	key, err := property(feature.Properties, p.keyProperty)
+	if id, ok := feature.ID.(string); err != nil && ok && id != "" {
+		key, err = id, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal(feature.Properties, value); err != nil || feature.Geometry == nil {
+		return key, err
+	}
+
+	// the point goes over the coordinates of the properties, if any
+	point, err := json.Marshal(map[string]json.RawMessage{"coordinates": feature.Geometry.Coordinates})
+	if err != nil {
+		return key, err
+	}
+
+	return key, json.Unmarshal(point, value)
+}
+
+// chunker collects the entries and sends them in chunks
+type chunker struct {
+	ctx    context.Context
+	chunks chan<- []Entry
+	chunk  []Entry
+}
+
+// add adds the entry to the chunk and sends the chunk once it is full
+func (c *chunker) add(entry Entry) error {
+	c.chunk = append(c.chunk, entry)
+	if len(c.chunk) < cap(c.chunk) {
+		return nil
+	}
+
+	if err := c.send(); err != nil {
+		return err
+	}
+	c.chunk = make([]Entry, 0, cap(c.chunk))
+
+	return nil
+}
+
+// flush sends the last chunk which is not full
+func (c *chunker) flush() error {
+	if len(c.chunk) == 0 {
+		return nil
+	}
+
+	return c.send()
+}
+
+// send waits until the consumer takes the chunk or the parsing is stopped
+func (c *chunker) send() error {
+	select {
+	case c.chunks <- c.chunk:
+		return nil
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+// detectFormat guesses the format of the file from its beginning, FormatObject when it can't tell
+func detectFormat(r *bufio.Reader) Format {
+	head, _ := r.Peek(r.Size())
+	dec := json.NewDecoder(bytes.NewReader(head))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return FormatObject
+	}
+	if tok == json.Delim('[') {
+		return FormatArray
+	}
+	if tok != json.Delim('{') {
+		return FormatObject
+	}
+
+	// the members of the FeatureCollection come in any order, like bbox or crs before its type and features,
+	// so all the members in the head are looked at, the first one tells the other formats apart
+	var first json.RawMessage
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			break
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			break
+		}
+		if first == nil {
+			first = value
+		}
+
+		var typ string
+		switch {
+		case key == "type" && json.Unmarshal(value, &typ) == nil && typ == "FeatureCollection":
+			return FormatGeoJSON
+		case key == "features" && len(value) > 0 && value[0] == '[':
+			return FormatGeoJSON
+		}
+	}
+
+	switch {
+	case len(first) == 0, first[0] == '{':
+		// the values of the keyed object are objects while the ones of the properties rarely are
+		return FormatObject
+	default:
+		return FormatNDJSON
+	}
+}
+
+// expectDelim reads the next token which has to be the delimiter, a file cut short ends before it
+func expectDelim(dec *json.Decoder, delim json.Delim, msg string) error {
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+
+	if tok != delim {
+		return fmt.Errorf("error reading json. %s", msg)
+	}
+
+	return nil
+}
+
+// property reads the string property of the json object
+func property(raw json.RawMessage, name string) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil {
+		return "", err
+	} else if tok != json.Delim('{') {
+		return "", errors.New("value must be a json object")
+	}
+
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+
+		if key != name {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		var value string
+		if err := dec.Decode(&value); err != nil || value == "" {
+			return "", fmt.Errorf("%s must be a non empty string", name)
+		}
+
+		return value, nil
+	}
+
+	return "", fmt.Errorf("%s is missing", name)
+}
+
+// readError is the error of the file itself rather than of its content
+type readError struct {
+	err error
+}
+
+func (e readError) Error() string {
+	return e.err.Error()
+}
+
+// readErrReader marks the errors of the file, so they are told apart from the values which do not fit the type,
+// it stops reading once the context is done
+type readErrReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r readErrReader) Read(b []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, readError{err}
+	}
+
+	n, err := r.r.Read(b)
+	if err != nil && err != io.EOF {
+		err = readError{err}
+	}
+
+	return n, err
+}
+
+// broken reports whether the decoding error leaves the rest of the file unreadable
+func broken(err error) bool {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var readErr readError
+	return errors.As(err, &readErr)
+}
+
+// unwrapReadErr returns the original error of the file
+func unwrapReadErr(err error) error {
+	var readErr readError
+	if errors.As(err, &readErr) {
+		return readErr.err
+	}
 
-	return readChan, errChan, nil
+	return err
 }
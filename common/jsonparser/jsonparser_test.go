@@ -0,0 +1,327 @@
+package jsonparser
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"runtime"
+	"testing"
+)
+
+type port struct {
+	Name        string    `json:"name"`
+	City        string    `json:"city"`
+	Country     string    `json:"country"`
+	Alias       []string  `json:"alias"`
+	Regions     []string  `json:"regions"`
+	Coordinates []float64 `json:"coordinates"`
+	Timezone    string    `json:"timezone"`
+	Unlocks     []string  `json:"unlocks"`
+	Code        string    `json:"code"`
+}
+
+func newPort() interface{} {
+	return new(port)
+}
+
+func TestParser_ParseFile(t *testing.T) {
+	file := `{"NLRTM": {"name": "Rotterdam"}, "AEAJM": {"name": "Ajman", "coordinates": "north"},
+ "DEHAM": {"name": "Hamburg", "unlocks": ["DEHAM"]}}`
+
+	cases := []struct {
+		name    string
+		file    string
+		chunk   int
+		keys    []string
+		offsets []int64
+		failed  []string
+		err     bool
+	}{
+		{
+			name:    "keeps file order",
+			file:    file,
+			chunk:   2,
+			keys:    []string{"NLRTM", "AEAJM", "DEHAM"},
+			offsets: []int64{1, 33, 86},
+			failed:  []string{"AEAJM"},
+		},
+		{
+			name:  "broken file",
+			file:  `{"NLRTM": {"name": "Rotterdam"}, "DEHAM": {"name": `,
+			chunk: 5,
+			err:   true,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			stream := New(16, test.chunk, newPort).Parse(context.Background(), bytes.NewBufferString(test.file))
+			defer stream.Close()
+
+			var (
+				keys    []string
+				offsets []int64
+				failed  []string
+			)
+			for entries, ok := stream.Next(); ok; entries, ok = stream.Next() {
+				for _, entry := range entries {
+					keys = append(keys, entry.Key)
+					offsets = append(offsets, entry.Offset)
+					if entry.Err != nil {
+						failed = append(failed, entry.Key)
+						continue
+					}
+					r.NotEmpty(entry.Value.(*port).Name)
+				}
+			}
+
+			if test.err {
+				r.Error(stream.Err())
+				return
+			}
+
+			r.NoError(stream.Err())
+			r.Equal(test.keys, keys)
+			r.Equal(test.offsets, offsets)
+			r.Equal(test.failed, failed)
+		})
+	}
+}
+
+func TestParser_Formats(t *testing.T) {
+	cases := []struct {
+		name   string
+		file   string
+		format Format
+		keys   []string
+		failed []string
+		coords [][]float64
+		err    bool
+	}{
+		{
+			name:   "array",
+			file:   `[{"slug": "NLRTM", "name": "Rotterdam"}, {"name": "Hamburg"}, {"slug": "DEHAM", "name": "Hamburg"}]`,
+			keys:   []string{"NLRTM", "", "DEHAM"},
+			failed: []string{""},
+		},
+		{
+			name: "ndjson",
+			file: `{"slug": "NLRTM", "name": "Rotterdam", "coordinates": [4.4, 51.9]}
+{"slug": "DEHAM", "name": "Hamburg"}
+`,
+			keys:   []string{"NLRTM", "DEHAM"},
+			coords: [][]float64{{4.4, 51.9}, nil},
+		},
+		{
+			name: "geojson",
+			file: `{"type": "FeatureCollection", "features": [
+ {"type": "Feature", "geometry": {"type": "Point", "coordinates": [4.4, 51.9]}, "properties": {"slug": "NLRTM", "name": "Rotterdam"}},
+ {"type": "Feature", "id": "DEHAM", "geometry": {"type": "Point", "coordinates": [9.9, 53.5]}, "properties": {"name": "Hamburg"}},
+ {"type": "Feature", "geometry": {"type": "LineString", "coordinates": [[1, 2], [3, 4]]}, "properties": {"slug": "XXXXX"}},
+ {"type": "Feature", "id": "BEANR", "geometry": null, "properties": {"name": "Antwerp"}}
+]}`,
+			keys:   []string{"NLRTM", "DEHAM", "", "BEANR"},
+			failed: []string{""},
+			coords: [][]float64{{4.4, 51.9}, {9.9, 53.5}, nil, nil},
+		},
+		{
+			name: "geojson with leading bbox",
+			file: `{"bbox": [4.4, 51.9, 9.9, 53.5], "type": "FeatureCollection", "features": [
+ {"type": "Feature", "geometry": {"type": "Point", "coordinates": [4.4, 51.9]}, "properties": {"slug": "NLRTM", "name": "Rotterdam"}}
+]}`,
+			keys:   []string{"NLRTM"},
+			coords: [][]float64{{4.4, 51.9}},
+		},
+		{
+			name: "geojson with leading crs",
+			file: `{"crs": {"type": "name", "properties": {"name": "urn:ogc:def:crs:OGC:1.3:CRS84"}}, "features": [
+ {"type": "Feature", "id": "DEHAM", "geometry": {"type": "Point", "coordinates": [9.9, 53.5]}, "properties": {"name": "Hamburg"}}
+], "type": "FeatureCollection"}`,
+			keys:   []string{"DEHAM"},
+			coords: [][]float64{{9.9, 53.5}},
+		},
+		{
+			name:   "format set",
+			file:   `{"NLRTM": {"name": "Rotterdam"}}`,
+			format: FormatArray,
+			err:    true,
+		},
+		{
+			name: "ndjson cut short",
+			file: `{"slug": "NLRTM", "name": "Rotterdam"}
+{"slug": "DEHAM", "na`,
+			err: true,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			parser := New(16, 2, newPort).Format(test.format)
+			stream := parser.Parse(context.Background(), bytes.NewBufferString(test.file))
+			defer stream.Close()
+
+			var (
+				keys   []string
+				failed []string
+				coords [][]float64
+			)
+			for entries, ok := stream.Next(); ok; entries, ok = stream.Next() {
+				for _, entry := range entries {
+					keys = append(keys, entry.Key)
+					if entry.Err != nil {
+						failed = append(failed, entry.Key)
+						coords = append(coords, nil)
+						continue
+					}
+					coords = append(coords, entry.Value.(*port).Coordinates)
+				}
+			}
+
+			if test.err {
+				r.Error(stream.Err())
+				return
+			}
+
+			r.NoError(stream.Err())
+			r.Equal(test.keys, keys)
+			r.Equal(test.failed, failed)
+			if test.coords != nil {
+				r.Equal(test.coords, coords)
+			}
+		})
+	}
+}
+
+func TestParser_ParseStops(t *testing.T) {
+	cases := []struct {
+		name string
+		stop func(cancel context.CancelFunc, stream *Stream)
+	}{
+		{
+			name: "context done",
+			stop: func(cancel context.CancelFunc, _ *Stream) { cancel() },
+		},
+		{
+			name: "stream closed",
+			stop: func(_ context.CancelFunc, stream *Stream) { stream.Close() },
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stream := New(16, 2, newPort).Backlog(1).Parse(ctx, &endlessFile{})
+			_, ok := stream.Next()
+			r.True(ok)
+
+			test.stop(cancel, stream)
+			// the parser stops even when nobody reads the stream anymore
+			stream.Close()
+
+			_, ok = stream.Next()
+			r.False(ok)
+			r.Equal(context.Canceled, stream.Err())
+		})
+	}
+}
+
+// endlessFile is the json object which never ends
+type endlessFile struct {
+	buf bytes.Buffer
+	n   int
+}
+
+func (f *endlessFile) Read(b []byte) (int, error) {
+	if f.n == 0 {
+		f.buf.WriteString("{")
+	}
+	for f.buf.Len() < len(b) {
+		fmt.Fprintf(&f.buf, `"XX%05d": {"name": "Port %d"},`, f.n, f.n)
+		f.n++
+	}
+
+	return f.buf.Read(b)
+}
+
+func BenchmarkParser_ParseFile(b *testing.B) {
+	file := portsFile(20000)
+
+	b.Run("typed", func(b *testing.B) {
+		benchmarkParse(b, file, newPort, func(Entry) {})
+	})
+
+	// how the upload decoded the ports before, every entry went through a map and json again
+	b.Run("map round trip", func(b *testing.B) {
+		newMap := func() interface{} { return new(map[string]interface{}) }
+		benchmarkParse(b, file, newMap, func(entry Entry) {
+			data, _ := json.Marshal(entry.Value)
+			_ = json.Unmarshal(data, new(port))
+		})
+	})
+}
+
+// benchmarkParse parses the file b.N times and reports the highest heap the parsing takes on top of the file
+func benchmarkParse(b *testing.B, file []byte, newValue NewValueFunc, use func(Entry)) {
+	var (
+		stats   runtime.MemStats
+		maxHeap uint64
+	)
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	baseHeap := stats.HeapInuse
+	maxHeap = baseHeap
+	b.SetBytes(int64(len(file)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		stream := New(64<<10, 20, newValue).Parse(context.Background(), bytes.NewReader(file))
+		for chunks := 0; ; chunks++ {
+			entries, ok := stream.Next()
+			if !ok {
+				break
+			}
+			for _, entry := range entries {
+				use(entry)
+			}
+
+			if chunks%100 == 0 {
+				runtime.ReadMemStats(&stats)
+				if stats.HeapInuse > maxHeap {
+					maxHeap = stats.HeapInuse
+				}
+			}
+		}
+
+		if err := stream.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportMetric(float64(maxHeap-baseHeap)/(1<<20), "heap-MB")
+}
+
+// portsFile makes the ports json file with n ports
+func portsFile(n int) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteString(",\n")
+		}
+		fmt.Fprintf(&buf, `"XX%05d": {"name": "Port %d", "city": "City %d", "country": "Country", "alias": [],
+ "regions": [], "coordinates": [55.5136433, 25.4052165], "timezone": "Asia/Dubai", "unlocks": ["XX%05d"], "code": "52000"}`,
+			i, i, i, i)
+	}
+	buf.WriteString("}")
+
+	return buf.Bytes()
+}
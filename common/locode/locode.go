@@ -0,0 +1,214 @@
+// Package locode reads the locations of the UN/LOCODE code list published by UNECE
+package locode
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Columns of the code list csv
+const (
+	ColChange = iota
+	ColCountry
+	ColLocation
+	ColName
+	ColNameWoDiacritics
+	ColSubdivision
+	ColFunction
+	ColStatus
+	ColDate
+	ColIATA
+	ColCoordinates
+	ColRemarks
+)
+
+// minColumns is the number of columns up to the coordinates, the remarks are often left out
+const minColumns = ColCoordinates + 1
+
+// Location is a single entry of the code list
+type Location struct {
+	// Change tells how the entry has changed since the previous release, X marks the entry to be removed
+	Change           string
+	Country          string
+	Location         string
+	Name             string
+	NameWoDiacritics string
+	Subdivision      string
+	// Function tells what the location is used for, 1 on the first position for a port
+	Function string
+	// Status tells who has approved the entry, XX marks the entry to be removed
+	Status      string
+	Date        string
+	IATA        string
+	Coordinates string
+	Remarks     string
+}
+
+// FromRecord reads the location from the csv record of the code list
+func FromRecord(record []string) (Location, error) {
+	if len(record) < minColumns {
+		return Location{}, fmt.Errorf("record has %d columns, %d expected", len(record), minColumns)
+	}
+
+	col := func(i int) string {
+		if i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	return Location{
+		Change:           col(ColChange),
+		Country:          strings.ToUpper(col(ColCountry)),
+		Location:         strings.ToUpper(col(ColLocation)),
+		Name:             col(ColName),
+		NameWoDiacritics: col(ColNameWoDiacritics),
+		Subdivision:      col(ColSubdivision),
+		Function:         col(ColFunction),
+		Status:           col(ColStatus),
+		Date:             col(ColDate),
+		IATA:             col(ColIATA),
+		Coordinates:      col(ColCoordinates),
+		Remarks:          col(ColRemarks),
+	}, nil
+}
+
+// Code is the UN/LOCODE of the location, the country followed by the location code, like NLRTM
+func (l Location) Code() string {
+	return l.Country + l.Location
+}
+
+// IsCountry reports whether the entry is the heading of the country rather than a location
+func (l Location) IsCountry() bool {
+	return l.Location == ""
+}
+
+// IsPort reports whether the location is a port
+func (l Location) IsPort() bool {
+	return strings.HasPrefix(l.Function, "1")
+}
+
+// Removed reports whether the entry is marked to be removed from the code list
+func (l Location) Removed() bool {
+	return l.Change == "X" || l.Status == "XX"
+}
+
+// CountryName is the english name of the country of the location, the country code when it is unknown
+func (l Location) CountryName() string {
+	region, err := language.ParseRegion(l.Country)
+	if err != nil {
+		return l.Country
+	}
+
+	if name := display.English.Regions().Name(region); name != "" {
+		return name
+	}
+
+	return l.Country
+}
+
+// Codes is the set of the UN/LOCODEs of the code list
+type Codes map[string]struct{}
+
+// ReadCodes reads the codes of the locations of the code list csv, the headings of the countries
+// and the entries to be removed are left out
+func ReadCodes(r io.Reader) (Codes, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	codes := Codes{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return codes, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		loc, err := FromRecord(record)
+		if err != nil {
+			return nil, err
+		}
+
+		if loc.IsCountry() || loc.Removed() {
+			continue
+		}
+
+		codes[loc.Code()] = struct{}{}
+	}
+}
+
+// Has reports whether the code is in the code list
+func (c Codes) Has(code string) bool {
+	_, ok := c[code]
+
+	return ok
+}
+
+// ParseCoordinates reads the coordinates in degrees and minutes the code list uses, like 5155N 00430E
+func ParseCoordinates(value string) (lat, lng float64, err error) {
+	parts := strings.Fields(value)
+	if len(parts) != 2 {
+		return 0, 0, errors.New("coordinates must be latitude and longitude like 5155N 00430E")
+	}
+
+	if lat, err = ParseDegrees(parts[0]); err != nil {
+		return 0, 0, err
+	}
+
+	if lng, err = ParseDegrees(parts[1]); err != nil {
+		return 0, 0, err
+	}
+
+	return lat, lng, nil
+}
+
+// ParseDegrees reads latitude like 5155N or longitude like 00430E in degrees and minutes
+func ParseDegrees(value string) (float64, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) < 4 {
+		return 0, fmt.Errorf("%q is not in degrees and minutes", value)
+	}
+
+	digits, hemisphere := value[:len(value)-1], value[len(value)-1]
+
+	limit := 90.0
+	switch {
+	case len(digits) == 4 && (hemisphere == 'N' || hemisphere == 'S'):
+	case len(digits) == 5 && (hemisphere == 'E' || hemisphere == 'W'):
+		limit = 180
+	default:
+		return 0, fmt.Errorf("%q is not in degrees and minutes", value)
+	}
+
+	sign := 1.0
+	if hemisphere == 'S' || hemisphere == 'W' {
+		sign = -1
+	}
+
+	degrees, err := strconv.Atoi(digits[:len(digits)-2])
+	if err != nil {
+		return 0, fmt.Errorf("%q is not in degrees and minutes", value)
+	}
+
+	minutes, err := strconv.Atoi(digits[len(digits)-2:])
+	if err != nil || minutes >= 60 {
+		return 0, fmt.Errorf("%q is not in degrees and minutes", value)
+	}
+
+	result := float64(degrees) + float64(minutes)/60
+	if result > limit {
+		return 0, fmt.Errorf("%q is out of range", value)
+	}
+
+	return sign * result, nil
+}
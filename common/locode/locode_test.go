@@ -0,0 +1,139 @@
+package locode
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestFromRecord(t *testing.T) {
+	cases := []struct {
+		name     string
+		record   []string
+		location Location
+		code     string
+		country  bool
+		port     bool
+		removed  bool
+		err      string
+	}{
+		{
+			name:   "port",
+			record: []string{"", "nl", "rtm", "Rotterdam", "Rotterdam", "ZH", "12345---", "AI", "0401", "", "5155N 00430E", "Maasvlakte"},
+			location: Location{
+				Country: "NL", Location: "RTM", Name: "Rotterdam", NameWoDiacritics: "Rotterdam", Subdivision: "ZH",
+				Function: "12345---", Status: "AI", Date: "0401", Coordinates: "5155N 00430E", Remarks: "Maasvlakte",
+			},
+			code: "NLRTM",
+			port: true,
+		},
+		{
+			name:   "without remarks",
+			record: []string{"", "NL", "AMS", "Amsterdam", "Amsterdam", "NH", "-2345---", "AI", "0401", "", "5223N 00454E"},
+			location: Location{
+				Country: "NL", Location: "AMS", Name: "Amsterdam", NameWoDiacritics: "Amsterdam", Subdivision: "NH",
+				Function: "-2345---", Status: "AI", Date: "0401", Coordinates: "5223N 00454E",
+			},
+			code: "NLAMS",
+		},
+		{
+			name:     "country heading",
+			record:   []string{"", "NL", "", ".NETHERLANDS", ".NETHERLANDS", "", "", "", "", "", ""},
+			location: Location{Country: "NL", Name: ".NETHERLANDS", NameWoDiacritics: ".NETHERLANDS"},
+			code:     "NL",
+			country:  true,
+		},
+		{
+			name:     "removed",
+			record:   []string{"X", "NL", "OLD", "Old port", "Old port", "", "1-------", "XX", "0401", "", ""},
+			location: Location{Change: "X", Country: "NL", Location: "OLD", Name: "Old port", NameWoDiacritics: "Old port", Function: "1-------", Status: "XX", Date: "0401"},
+			code:     "NLOLD",
+			port:     true,
+			removed:  true,
+		},
+		{
+			name:   "too few columns",
+			record: []string{"", "NL", "RTM", "Rotterdam"},
+			err:    "record has 4 columns, 11 expected",
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			loc, err := FromRecord(test.record)
+			if test.err != "" {
+				r.EqualError(err, test.err)
+				return
+			}
+
+			r.NoError(err)
+			r.Equal(test.location, loc)
+			r.Equal(test.code, loc.Code())
+			r.Equal(test.country, loc.IsCountry())
+			r.Equal(test.port, loc.IsPort())
+			r.Equal(test.removed, loc.Removed())
+		})
+	}
+}
+
+func TestLocation_CountryName(t *testing.T) {
+	require.Equal(t, "Netherlands", Location{Country: "NL"}.CountryName())
+	require.Equal(t, "XX", Location{Country: "XX"}.CountryName())
+}
+
+func TestParseCoordinates(t *testing.T) {
+	cases := []struct {
+		value string
+		lat   float64
+		lng   float64
+		err   bool
+	}{
+		{value: "5155N 00430E", lat: 51 + 55.0/60, lng: 4.5},
+		{value: "3352S 15112E", lat: -(33 + 52.0/60), lng: 151 + 12.0/60},
+		{value: "4043N 07400W", lat: 40 + 43.0/60, lng: -74},
+		{value: "0000N 18000E", lng: 180},
+		{value: "5155N", err: true},
+		{value: "5155E 00430N", err: true},
+		{value: "5160N 00430E", err: true},
+		{value: "9100N 00430E", err: true},
+		{value: "5155N 18100E", err: true},
+		{value: "51x5N 00430E", err: true},
+	}
+
+	for _, test := range cases {
+		t.Run(test.value, func(t *testing.T) {
+			r := require.New(t)
+
+			lat, lng, err := ParseCoordinates(test.value)
+			if test.err {
+				r.Error(err)
+				return
+			}
+
+			r.NoError(err)
+			r.InDelta(test.lat, lat, 1e-9)
+			r.InDelta(test.lng, lng, 1e-9)
+		})
+	}
+}
+
+func TestReadCodes(t *testing.T) {
+	r := require.New(t)
+
+	codes, err := ReadCodes(strings.NewReader(`,"NL",,".NETHERLANDS",".NETHERLANDS",,,,,,,
+,"NL","RTM","Rotterdam","Rotterdam","ZH","12345---","AI","0401",,"5155N 00430E",
+,"NL","AMS","Amsterdam","Amsterdam","NH","-2345---","AI","0401",,"5223N 00454E",
+X,"NL","OLD","Old port","Old port",,"1-------","XX","0401",,,
+,"CI","ABJ","Abidjan","Abidjan",,"1-3-----","AI","0401",,"0519N 00402W","Port "Autonome"
+`))
+	r.NoError(err)
+	r.Equal(Codes{"NLRTM": {}, "NLAMS": {}, "CIABJ": {}}, codes)
+	r.True(codes.Has("NLRTM"))
+	r.False(codes.Has("NLOLD"))
+	r.False(codes.Has("NL"))
+
+	_, err = ReadCodes(strings.NewReader(",\"NL\",\"RTM\"\n"))
+	r.EqualError(err, "record has 3 columns, 11 expected")
+}
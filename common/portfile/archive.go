@@ -0,0 +1,142 @@
+package portfile
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+// Extensions are the extensions of the ports files taken from the zip archives, optionally followed by .gz
+var Extensions = []string{".json", ".ndjson", ".jsonl", ".geojson", ".csv"}
+
+// ErrNoPortsFiles is returned for the zip archive without any ports file in it
+var ErrNoPortsFiles = errors.New("zip archive has no ports files")
+
+// Part is a ports file of the upload, either the upload itself or one of the files of its zip archive
+type Part struct {
+	// Name is the file name without the .gz extension, so it tells the csv files apart still
+	Name string
+	// Archived tells the part is a file of the zip archive
+	Archived bool
+	open     func() (io.ReadCloser, error)
+}
+
+// Open opens the part for reading, gzip compressed part is decompressed on the fly
+func (p Part) Open() (io.ReadCloser, error) {
+	return p.open()
+}
+
+// Parts returns the ports files of the upload named name, the zip archive gives every ports file in it,
+// any other upload is a single part
+func Parts(file io.ReaderAt, size int64, name string) ([]Part, error) {
+	magic := make([]byte, len(zipMagic))
+	n, err := file.ReadAt(magic, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if !bytes.Equal(magic[:n], zipMagic) {
+		return []Part{{
+			Name: trimGzip(name),
+			open: func() (io.ReadCloser, error) {
+				return gunzip(io.NopCloser(io.NewSectionReader(file, 0, size)))
+			},
+		}}, nil
+	}
+
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []Part
+	for _, f := range archive.File {
+		if !portsFile(f) {
+			continue
+		}
+
+		f := f
+		parts = append(parts, Part{
+			Name:     trimGzip(f.Name),
+			Archived: true,
+			open: func() (io.ReadCloser, error) {
+				r, err := f.Open()
+				if err != nil {
+					return nil, err
+				}
+
+				return gunzip(r)
+			},
+		})
+	}
+
+	if len(parts) == 0 {
+		return nil, ErrNoPortsFiles
+	}
+
+	return parts, nil
+}
+
+// portsFile reports whether the file of the zip archive is a ports file,
+// the directories and the metadata like __MACOSX or .DS_Store are skipped
+func portsFile(f *zip.File) bool {
+	if f.FileInfo().IsDir() || strings.HasPrefix(f.Name, "__MACOSX/") || strings.HasPrefix(path.Base(f.Name), ".") {
+		return false
+	}
+
+	ext := strings.ToLower(path.Ext(trimGzip(f.Name)))
+	for _, e := range Extensions {
+		if ext == e {
+			return true
+		}
+	}
+
+	return false
+}
+
+// gunzip decompresses the gzip stream, any other stream is read as it is
+func gunzip(r io.ReadCloser) (io.ReadCloser, error) {
+	buf := bufio.NewReader(r)
+	magic, err := buf.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		_ = r.Close()
+		return nil, err
+	}
+
+	if !bytes.Equal(magic, gzipMagic) {
+		return readCloser{Reader: buf, Closer: r}, nil
+	}
+
+	gz, err := gzip.NewReader(buf)
+	if err != nil {
+		_ = r.Close()
+		return nil, err
+	}
+
+	return readCloser{Reader: gz, Closer: r}, nil
+}
+
+// trimGzip drops the .gz extension of the name
+func trimGzip(name string) string {
+	if strings.EqualFold(path.Ext(name), ".gz") {
+		return name[:len(name)-len(".gz")]
+	}
+
+	return name
+}
+
+// readCloser reads from the decompressed stream and closes the underlying one
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,101 @@
+package portfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"testing"
+)
+
+func gzipped(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	return buf.Bytes()
+}
+
+func zipped(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, data := range files {
+		w, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+
+	return buf.Bytes()
+}
+
+func TestParts(t *testing.T) {
+	ports := `{"NLRTM": {"name": "Rotterdam"}}`
+	table := "slug,name\nDEHAM,Hamburg\n"
+
+	cases := []struct {
+		name   string
+		upload string
+		data   []byte
+		parts  map[string]string
+		err    error
+	}{
+		{
+			name:   "plain",
+			upload: "ports.json",
+			data:   []byte(ports),
+			parts:  map[string]string{"ports.json": ports},
+		},
+		{
+			name:   "gzip",
+			upload: "ports.csv.gz",
+			data:   gzipped(t, table),
+			parts:  map[string]string{"ports.csv": table},
+		},
+		{
+			name:   "zip",
+			upload: "ports.zip",
+			data: zipped(t, map[string][]byte{
+				"data/ports.json":            []byte(ports),
+				"data/ports.csv.gz":          gzipped(t, table),
+				"data/readme.txt":            []byte("ports of the world"),
+				"__MACOSX/data/._ports.json": []byte("metadata"),
+			}),
+			parts: map[string]string{"data/ports.json": ports, "data/ports.csv": table},
+		},
+		{
+			name:   "zip without ports files",
+			upload: "ports.zip",
+			data:   zipped(t, map[string][]byte{"readme.txt": []byte("ports of the world")}),
+			err:    ErrNoPortsFiles,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			parts, err := Parts(bytes.NewReader(test.data), int64(len(test.data)), test.upload)
+			if test.err != nil {
+				r.Equal(test.err, err)
+				return
+			}
+			r.NoError(err)
+
+			read := map[string]string{}
+			for _, part := range parts {
+				f, err := part.Open()
+				r.NoError(err)
+				data, err := ioutil.ReadAll(f)
+				r.NoError(err)
+				r.NoError(f.Close())
+				read[part.Name] = string(data)
+			}
+			r.Equal(test.parts, read)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Package portfile reads the ports files of every supported format as a single stream of entries
+package portfile
+
+import (
+	"context"
+	"github.com/kreyyser/transshipment/common/csvparser"
+	"github.com/kreyyser/transshipment/common/jsonparser"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Formats are the formats of the ports files, the json ones of jsonparser and the csv ones of csvparser
+var Formats = []string{
+	string(jsonparser.FormatObject),
+	string(jsonparser.FormatArray),
+	string(jsonparser.FormatNDJSON),
+	string(jsonparser.FormatGeoJSON),
+	string(csvparser.FormatTable),
+	string(csvparser.FormatUNLOCODE),
+}
+
+// Options tell how the file is read
+type Options struct {
+	// Format is one of Formats, the format is detected when it is empty
+	Format string
+	// FileName tells the csv files apart when the format is not set
+	FileName string
+	// SlugProperty is the property or column the slugs are read from, unless the format is object or unlocode
+	SlugProperty string
+	// BufferSize is the number of bytes read from the json file at once
+	BufferSize int
+	// ChunkSize is the number of entries the stream sends at once
+	ChunkSize int
+}
+
+// Valid reports whether the format is known, empty one included
+func Valid(format string) bool {
+	if format == "" {
+		return true
+	}
+
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Parse starts reading the ports of the file, their values are decoded into what newValue makes
+func Parse(ctx context.Context, file io.Reader, opts Options, newValue jsonparser.NewValueFunc) *jsonparser.Stream {
+	parser := jsonparser.New(opts.BufferSize, opts.ChunkSize, newValue)
+
+	format := opts.Format
+	if format == "" && strings.EqualFold(filepath.Ext(opts.FileName), ".csv") {
+		format = string(csvparser.FormatTable)
+	}
+
+	switch format {
+	case string(csvparser.FormatTable), string(csvparser.FormatUNLOCODE):
+		csv := csvparser.New(csvparser.Format(format), newValue)
+		if opts.SlugProperty != "" {
+			csv = csv.KeyColumn(opts.SlugProperty)
+		}
+
+		return parser.Stream(ctx, csv.Source(file))
+	default:
+		parser = parser.Format(jsonparser.Format(format))
+		if opts.SlugProperty != "" {
+			parser = parser.KeyProperty(opts.SlugProperty)
+		}
+
+		return parser.Parse(ctx, file)
+	}
+}
@@ -1,16 +1,20 @@
 module github.com/kreyyser/transshipment
 
-go 1.15
+go 1.16
 
 require (
 	github.com/envoyproxy/protoc-gen-validate v0.1.0
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/golang/protobuf v1.4.2
+	github.com/jackc/pgconn v1.7.0
+	github.com/jackc/pgx/v4 v4.9.0
 	github.com/lib/pq v1.3.0
 	github.com/oklog/run v1.1.0
 	github.com/spf13/pflag v1.0.3
 	github.com/spf13/viper v1.7.1
 	github.com/stretchr/testify v1.5.1
+	golang.org/x/text v0.3.3
+	google.golang.org/genproto v0.0.0-20191108220845-16a3f7862a1a
 	google.golang.org/grpc v1.33.0
 	google.golang.org/protobuf v1.23.0
 	gorm.io/driver/postgres v1.0.2
@@ -10,7 +10,9 @@ import (
 	context "context"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -31,12 +33,351 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// ImportMode tells what happens to the ports missing from the import
+type ImportMode int32
+
+const (
+	// IMPORT_MODE_UPSERT keeps the ports missing from the import
+	ImportMode_IMPORT_MODE_UPSERT ImportMode = 0
+	// IMPORT_MODE_SYNC deletes the ports missing from the import
+	ImportMode_IMPORT_MODE_SYNC ImportMode = 1
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "IMPORT_MODE_UPSERT",
+		1: "IMPORT_MODE_SYNC",
+	}
+	ImportMode_value = map[string]int32{
+		"IMPORT_MODE_UPSERT": 0,
+		"IMPORT_MODE_SYNC":   1,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_portentries_portentries_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_portentries_portentries_proto_enumTypes[0]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{0}
+}
+
+// ImportFormat is the shape of the imported file
+type ImportFormat int32
+
+const (
+	// IMPORT_FORMAT_AUTO detects the format from the beginning of the file
+	ImportFormat_IMPORT_FORMAT_AUTO ImportFormat = 0
+	// IMPORT_FORMAT_OBJECT is a json object of the ports keyed by their slugs
+	ImportFormat_IMPORT_FORMAT_OBJECT ImportFormat = 1
+	// IMPORT_FORMAT_ARRAY is a json array of the ports
+	ImportFormat_IMPORT_FORMAT_ARRAY ImportFormat = 2
+	// IMPORT_FORMAT_NDJSON is the ports one per line
+	ImportFormat_IMPORT_FORMAT_NDJSON ImportFormat = 3
+	// IMPORT_FORMAT_GEOJSON is a GeoJSON FeatureCollection of Point features with the ports as their properties
+	ImportFormat_IMPORT_FORMAT_GEOJSON ImportFormat = 4
+	// IMPORT_FORMAT_CSV is a csv file with the header row naming the port fields of its columns
+	ImportFormat_IMPORT_FORMAT_CSV ImportFormat = 5
+	// IMPORT_FORMAT_UNLOCODE is the UN/LOCODE code list csv of UNECE, only its ports are imported
+	ImportFormat_IMPORT_FORMAT_UNLOCODE ImportFormat = 6
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_AUTO",
+		1: "IMPORT_FORMAT_OBJECT",
+		2: "IMPORT_FORMAT_ARRAY",
+		3: "IMPORT_FORMAT_NDJSON",
+		4: "IMPORT_FORMAT_GEOJSON",
+		5: "IMPORT_FORMAT_CSV",
+		6: "IMPORT_FORMAT_UNLOCODE",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_AUTO":     0,
+		"IMPORT_FORMAT_OBJECT":   1,
+		"IMPORT_FORMAT_ARRAY":    2,
+		"IMPORT_FORMAT_NDJSON":   3,
+		"IMPORT_FORMAT_GEOJSON":  4,
+		"IMPORT_FORMAT_CSV":      5,
+		"IMPORT_FORMAT_UNLOCODE": 6,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_portentries_portentries_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_portentries_portentries_proto_enumTypes[1]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{1}
+}
+
+// ImportLoader tells how the staged ports get into the db
+type ImportLoader int32
+
+const (
+	// IMPORT_LOADER_INSERT stages the ports with INSERT and merges them batch by batch
+	ImportLoader_IMPORT_LOADER_INSERT ImportLoader = 0
+	// IMPORT_LOADER_COPY streams the ports into the staging table with COPY and merges them with set-based statements,
+	// meant for loading the whole dataset
+	ImportLoader_IMPORT_LOADER_COPY ImportLoader = 1
+)
+
+// Enum value maps for ImportLoader.
+var (
+	ImportLoader_name = map[int32]string{
+		0: "IMPORT_LOADER_INSERT",
+		1: "IMPORT_LOADER_COPY",
+	}
+	ImportLoader_value = map[string]int32{
+		"IMPORT_LOADER_INSERT": 0,
+		"IMPORT_LOADER_COPY":   1,
+	}
+)
+
+func (x ImportLoader) Enum() *ImportLoader {
+	p := new(ImportLoader)
+	*p = x
+	return p
+}
+
+func (x ImportLoader) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportLoader) Descriptor() protoreflect.EnumDescriptor {
+	return file_portentries_portentries_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportLoader) Type() protoreflect.EnumType {
+	return &file_portentries_portentries_proto_enumTypes[2]
+}
+
+func (x ImportLoader) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportLoader.Descriptor instead.
+func (ImportLoader) EnumDescriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{2}
+}
+
+// ConflictPolicy tells what the upsert does with the field of the stored port when it brings a different value,
+// the new ports are always stored as they are
+type ConflictPolicy int32
+
+const (
+	// CONFLICT_POLICY_OVERWRITE stores the new value
+	ConflictPolicy_CONFLICT_POLICY_OVERWRITE ConflictPolicy = 0
+	// CONFLICT_POLICY_SKIP_EXISTING keeps the stored value
+	ConflictPolicy_CONFLICT_POLICY_SKIP_EXISTING ConflictPolicy = 1
+	// CONFLICT_POLICY_FILL_EMPTY_ONLY stores the new value only when the stored one is empty
+	ConflictPolicy_CONFLICT_POLICY_FILL_EMPTY_ONLY ConflictPolicy = 2
+	// CONFLICT_POLICY_FAIL_ON_CONFLICT fails the upsert when the stored value is different
+	ConflictPolicy_CONFLICT_POLICY_FAIL_ON_CONFLICT ConflictPolicy = 3
+)
+
+// Enum value maps for ConflictPolicy.
+var (
+	ConflictPolicy_name = map[int32]string{
+		0: "CONFLICT_POLICY_OVERWRITE",
+		1: "CONFLICT_POLICY_SKIP_EXISTING",
+		2: "CONFLICT_POLICY_FILL_EMPTY_ONLY",
+		3: "CONFLICT_POLICY_FAIL_ON_CONFLICT",
+	}
+	ConflictPolicy_value = map[string]int32{
+		"CONFLICT_POLICY_OVERWRITE":        0,
+		"CONFLICT_POLICY_SKIP_EXISTING":    1,
+		"CONFLICT_POLICY_FILL_EMPTY_ONLY":  2,
+		"CONFLICT_POLICY_FAIL_ON_CONFLICT": 3,
+	}
+)
+
+func (x ConflictPolicy) Enum() *ConflictPolicy {
+	p := new(ConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_portentries_portentries_proto_enumTypes[3].Descriptor()
+}
+
+func (ConflictPolicy) Type() protoreflect.EnumType {
+	return &file_portentries_portentries_proto_enumTypes[3]
+}
+
+func (x ConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictPolicy.Descriptor instead.
+func (ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{3}
+}
+
+type ImportJobStatus int32
+
+const (
+	ImportJobStatus_IMPORT_JOB_STATUS_PENDING   ImportJobStatus = 0
+	ImportJobStatus_IMPORT_JOB_STATUS_RUNNING   ImportJobStatus = 1
+	ImportJobStatus_IMPORT_JOB_STATUS_SUCCEEDED ImportJobStatus = 2
+	ImportJobStatus_IMPORT_JOB_STATUS_FAILED    ImportJobStatus = 3
+)
+
+// Enum value maps for ImportJobStatus.
+var (
+	ImportJobStatus_name = map[int32]string{
+		0: "IMPORT_JOB_STATUS_PENDING",
+		1: "IMPORT_JOB_STATUS_RUNNING",
+		2: "IMPORT_JOB_STATUS_SUCCEEDED",
+		3: "IMPORT_JOB_STATUS_FAILED",
+	}
+	ImportJobStatus_value = map[string]int32{
+		"IMPORT_JOB_STATUS_PENDING":   0,
+		"IMPORT_JOB_STATUS_RUNNING":   1,
+		"IMPORT_JOB_STATUS_SUCCEEDED": 2,
+		"IMPORT_JOB_STATUS_FAILED":    3,
+	}
+)
+
+func (x ImportJobStatus) Enum() *ImportJobStatus {
+	p := new(ImportJobStatus)
+	*p = x
+	return p
+}
+
+func (x ImportJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_portentries_portentries_proto_enumTypes[4].Descriptor()
+}
+
+func (ImportJobStatus) Type() protoreflect.EnumType {
+	return &file_portentries_portentries_proto_enumTypes[4]
+}
+
+func (x ImportJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportJobStatus.Descriptor instead.
+func (ImportJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{4}
+}
+
+type PortResult_Status int32
+
+const (
+	PortResult_STATUS_UNSPECIFIED PortResult_Status = 0
+	PortResult_CREATED            PortResult_Status = 1
+	PortResult_UPDATED            PortResult_Status = 2
+	PortResult_UNCHANGED          PortResult_Status = 3
+	PortResult_FAILED             PortResult_Status = 4
+	// SKIPPED port is valid but was not stored as other ports failed without continue_on_error
+	PortResult_SKIPPED PortResult_Status = 5
+)
+
+// Enum value maps for PortResult_Status.
+var (
+	PortResult_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "UNCHANGED",
+		4: "FAILED",
+		5: "SKIPPED",
+	}
+	PortResult_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"CREATED":            1,
+		"UPDATED":            2,
+		"UNCHANGED":          3,
+		"FAILED":             4,
+		"SKIPPED":            5,
+	}
+)
+
+func (x PortResult_Status) Enum() *PortResult_Status {
+	p := new(PortResult_Status)
+	*p = x
+	return p
+}
+
+func (x PortResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_portentries_portentries_proto_enumTypes[5].Descriptor()
+}
+
+func (PortResult_Status) Type() protoreflect.EnumType {
+	return &file_portentries_portentries_proto_enumTypes[5]
+}
+
+func (x PortResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortResult_Status.Descriptor instead.
+func (PortResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{6, 0}
+}
+
 type CreatePortRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Data *Port `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// conflicts tell CreateOrUpdatePort how to change the stored port, CreatePort ignores them
+	Conflicts *Conflicts `protobuf:"bytes,2,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
 func (x *CreatePortRequest) Reset() {
@@ -78,6 +419,13 @@ func (x *CreatePortRequest) GetData() *Port {
 	return nil
 }
 
+func (x *CreatePortRequest) GetConflicts() *Conflicts {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -138,7 +486,13 @@ type UpsertPortBulkRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// every port is validated on its own, the invalid ones are reported in the response
 	Data []*Port `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// continue_on_error stores the valid ports even when some ports failed,
+	// otherwise nothing is stored when any port fails
+	ContinueOnError bool `protobuf:"varint,2,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	// conflicts tell how to change the stored ports, the ports failing on conflict are reported as FAILED
+	Conflicts *Conflicts `protobuf:"bytes,3,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
 func (x *UpsertPortBulkRequest) Reset() {
@@ -180,14 +534,37 @@ func (x *UpsertPortBulkRequest) GetData() []*Port {
 	return nil
 }
 
-type ListPortsRequest struct {
+func (x *UpsertPortBulkRequest) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+func (x *UpsertPortBulkRequest) GetConflicts() *Conflicts {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type UpsertPortBulkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// results hold the outcome of every port in the request order
+	Results   []*PortResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Created   int64         `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   int64         `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged int64         `protobuf:"varint,6,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Failed    int64         `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
-func (x *ListPortsRequest) Reset() {
-	*x = ListPortsRequest{}
+func (x *UpsertPortBulkResponse) Reset() {
+	*x = UpsertPortBulkResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portentries_portentries_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -195,13 +572,13 @@ func (x *ListPortsRequest) Reset() {
 	}
 }
 
-func (x *ListPortsRequest) String() string {
+func (x *UpsertPortBulkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPortsRequest) ProtoMessage() {}
+func (*UpsertPortBulkResponse) ProtoMessage() {}
 
-func (x *ListPortsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpsertPortBulkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_portentries_portentries_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -213,23 +590,77 @@ func (x *ListPortsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPortsRequest.ProtoReflect.Descriptor instead.
-func (*ListPortsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpsertPortBulkResponse.ProtoReflect.Descriptor instead.
+func (*UpsertPortBulkResponse) Descriptor() ([]byte, []int) {
 	return file_portentries_portentries_proto_rawDescGZIP(), []int{3}
 }
 
-type ListPortsResponse struct {
+func (x *UpsertPortBulkResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *UpsertPortBulkResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpsertPortBulkResponse) GetResults() []*PortResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *UpsertPortBulkResponse) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *UpsertPortBulkResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *UpsertPortBulkResponse) GetUnchanged() int64 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *UpsertPortBulkResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type StreamUpsertPortsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int64   `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Message    string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data       []*Port `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// mode, dry_run and loader are taken from the first message of the stream
+	Mode   ImportMode `protobuf:"varint,1,opt,name=mode,proto3,enum=ports.ImportMode" json:"mode,omitempty"`
+	DryRun bool       `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// every port is validated on its own, the invalid ones are reported in the response
+	Data   []*Port      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Loader ImportLoader `protobuf:"varint,4,opt,name=loader,proto3,enum=ports.ImportLoader" json:"loader,omitempty"`
+	// conflicts are taken from the first message as well
+	Conflicts *Conflicts `protobuf:"bytes,5,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
-func (x *ListPortsResponse) Reset() {
-	*x = ListPortsResponse{}
+func (x *StreamUpsertPortsRequest) Reset() {
+	*x = StreamUpsertPortsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portentries_portentries_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -237,13 +668,13 @@ func (x *ListPortsResponse) Reset() {
 	}
 }
 
-func (x *ListPortsResponse) String() string {
+func (x *StreamUpsertPortsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPortsResponse) ProtoMessage() {}
+func (*StreamUpsertPortsRequest) ProtoMessage() {}
 
-func (x *ListPortsResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamUpsertPortsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_portentries_portentries_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -255,54 +686,73 @@ func (x *ListPortsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPortsResponse.ProtoReflect.Descriptor instead.
-func (*ListPortsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamUpsertPortsRequest.ProtoReflect.Descriptor instead.
+func (*StreamUpsertPortsRequest) Descriptor() ([]byte, []int) {
 	return file_portentries_portentries_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListPortsResponse) GetStatusCode() int64 {
+func (x *StreamUpsertPortsRequest) GetMode() ImportMode {
 	if x != nil {
-		return x.StatusCode
+		return x.Mode
 	}
-	return 0
+	return ImportMode_IMPORT_MODE_UPSERT
 }
 
-func (x *ListPortsResponse) GetMessage() string {
+func (x *StreamUpsertPortsRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Message
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *ListPortsResponse) GetData() []*Port {
+func (x *StreamUpsertPortsRequest) GetData() []*Port {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type Port struct {
+func (x *StreamUpsertPortsRequest) GetLoader() ImportLoader {
+	if x != nil {
+		return x.Loader
+	}
+	return ImportLoader_IMPORT_LOADER_INSERT
+}
+
+func (x *StreamUpsertPortsRequest) GetConflicts() *Conflicts {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type StreamUpsertPortsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug     string               `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Id       *wrappers.Int64Value `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	City     string               `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
-	Province string               `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
-	Country  string               `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
-	Alias    []string             `protobuf:"bytes,7,rep,name=alias,proto3" json:"alias,omitempty"`
-	// TODO find out what type this field has. Assuming array of strings as alias
-	Regions     []string     `protobuf:"bytes,8,rep,name=regions,proto3" json:"regions,omitempty"`
-	Coordinates *Coordinates `protobuf:"bytes,9,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
-	Timezone    string       `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	Unlocks     []string     `protobuf:"bytes,11,rep,name=unlocks,proto3" json:"unlocks,omitempty"`
-	Code        string       `protobuf:"bytes,12,opt,name=code,proto3" json:"code,omitempty"`
+	StatusCode int64  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Created    int64  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated    int64  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged  int64  `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Removed    int64  `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+	DryRun     bool   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// received counts the ports of the whole stream
+	Received int64 `protobuf:"varint,8,opt,name=received,proto3" json:"received,omitempty"`
+	Failed   int64 `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`
+	// failed_ports hold the first of the failed ports, their index is the position in the whole stream
+	FailedPorts []*PortResult `protobuf:"bytes,10,rep,name=failed_ports,json=failedPorts,proto3" json:"failed_ports,omitempty"`
+	// aborted tells nothing was applied, as sync needs every port of the stream and some have failed
+	Aborted bool `protobuf:"varint,11,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	// warned counts the stored ports breaking the domain rules in lenient validation mode
+	Warned int64 `protobuf:"varint,12,opt,name=warned,proto3" json:"warned,omitempty"`
+	// warned_ports hold the first of the warned ports with their warnings
+	WarnedPorts []*PortResult `protobuf:"bytes,13,rep,name=warned_ports,json=warnedPorts,proto3" json:"warned_ports,omitempty"`
 }
 
-func (x *Port) Reset() {
-	*x = Port{}
+func (x *StreamUpsertPortsResponse) Reset() {
+	*x = StreamUpsertPortsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portentries_portentries_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -310,13 +760,13 @@ func (x *Port) Reset() {
 	}
 }
 
-func (x *Port) String() string {
+func (x *StreamUpsertPortsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Port) ProtoMessage() {}
+func (*StreamUpsertPortsResponse) ProtoMessage() {}
 
-func (x *Port) ProtoReflect() protoreflect.Message {
+func (x *StreamUpsertPortsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_portentries_portentries_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,106 +778,122 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Port.ProtoReflect.Descriptor instead.
-func (*Port) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamUpsertPortsResponse.ProtoReflect.Descriptor instead.
+func (*StreamUpsertPortsResponse) Descriptor() ([]byte, []int) {
 	return file_portentries_portentries_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Port) GetSlug() string {
+func (x *StreamUpsertPortsResponse) GetStatusCode() int64 {
 	if x != nil {
-		return x.Slug
+		return x.StatusCode
 	}
-	return ""
+	return 0
 }
 
-func (x *Port) GetId() *wrappers.Int64Value {
+func (x *StreamUpsertPortsResponse) GetMessage() string {
 	if x != nil {
-		return x.Id
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *Port) GetName() string {
+func (x *StreamUpsertPortsResponse) GetCreated() int64 {
 	if x != nil {
-		return x.Name
+		return x.Created
 	}
-	return ""
+	return 0
 }
 
-func (x *Port) GetCity() string {
+func (x *StreamUpsertPortsResponse) GetUpdated() int64 {
 	if x != nil {
-		return x.City
+		return x.Updated
 	}
-	return ""
+	return 0
 }
 
-func (x *Port) GetProvince() string {
+func (x *StreamUpsertPortsResponse) GetUnchanged() int64 {
 	if x != nil {
-		return x.Province
+		return x.Unchanged
 	}
-	return ""
+	return 0
 }
 
-func (x *Port) GetCountry() string {
+func (x *StreamUpsertPortsResponse) GetRemoved() int64 {
 	if x != nil {
-		return x.Country
+		return x.Removed
 	}
-	return ""
+	return 0
 }
 
-func (x *Port) GetAlias() []string {
+func (x *StreamUpsertPortsResponse) GetDryRun() bool {
 	if x != nil {
-		return x.Alias
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-func (x *Port) GetRegions() []string {
+func (x *StreamUpsertPortsResponse) GetReceived() int64 {
 	if x != nil {
-		return x.Regions
+		return x.Received
 	}
-	return nil
+	return 0
 }
 
-func (x *Port) GetCoordinates() *Coordinates {
+func (x *StreamUpsertPortsResponse) GetFailed() int64 {
 	if x != nil {
-		return x.Coordinates
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *StreamUpsertPortsResponse) GetFailedPorts() []*PortResult {
+	if x != nil {
+		return x.FailedPorts
 	}
 	return nil
 }
 
-func (x *Port) GetTimezone() string {
+func (x *StreamUpsertPortsResponse) GetAborted() bool {
 	if x != nil {
-		return x.Timezone
+		return x.Aborted
 	}
-	return ""
+	return false
 }
 
-func (x *Port) GetUnlocks() []string {
+func (x *StreamUpsertPortsResponse) GetWarned() int64 {
 	if x != nil {
-		return x.Unlocks
+		return x.Warned
 	}
-	return nil
+	return 0
 }
 
-func (x *Port) GetCode() string {
+func (x *StreamUpsertPortsResponse) GetWarnedPorts() []*PortResult {
 	if x != nil {
-		return x.Code
+		return x.WarnedPorts
 	}
-	return ""
+	return nil
 }
 
-type Coordinates struct {
+type PortResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lng float64 `protobuf:"fixed64,1,opt,name=lng,proto3" json:"lng,omitempty"`
-	Lat float64 `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	// index is the position of the port in the request
+	Index  int32             `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Slug   string            `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Status PortResult_Status `protobuf:"varint,3,opt,name=status,proto3,enum=ports.PortResult_Status" json:"status,omitempty"`
+	// id and version of the stored port
+	Id      int64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// errors tell why the port failed
+	Errors []*FieldError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	// warnings tell which domain rules the stored port breaks in lenient validation mode
+	Warnings []*FieldError `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *Coordinates) Reset() {
-	*x = Coordinates{}
+func (x *PortResult) Reset() {
+	*x = PortResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portentries_portentries_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -435,13 +901,13 @@ func (x *Coordinates) Reset() {
 	}
 }
 
-func (x *Coordinates) String() string {
+func (x *PortResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Coordinates) ProtoMessage() {}
+func (*PortResult) ProtoMessage() {}
 
-func (x *Coordinates) ProtoReflect() protoreflect.Message {
+func (x *PortResult) ProtoReflect() protoreflect.Message {
 	mi := &file_portentries_portentries_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -453,37 +919,74 @@ func (x *Coordinates) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
-func (*Coordinates) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortResult.ProtoReflect.Descriptor instead.
+func (*PortResult) Descriptor() ([]byte, []int) {
 	return file_portentries_portentries_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Coordinates) GetLng() float64 {
+func (x *PortResult) GetIndex() int32 {
 	if x != nil {
-		return x.Lng
+		return x.Index
 	}
 	return 0
 }
 
-func (x *Coordinates) GetLat() float64 {
+func (x *PortResult) GetSlug() string {
 	if x != nil {
-		return x.Lat
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PortResult) GetStatus() PortResult_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PortResult_STATUS_UNSPECIFIED
+}
+
+func (x *PortResult) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-type PortResponse struct {
+func (x *PortResult) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PortResult) GetErrors() []*FieldError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *PortResult) GetWarnings() []*FieldError {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type FieldError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int64  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data       *Port  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// field is the path of the invalid field, like coordinates.lat
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// rule names the validation or domain rule the field breaks, like required, range or iana_tz
+	Rule string `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
 }
 
-func (x *PortResponse) Reset() {
-	*x = PortResponse{}
+func (x *FieldError) Reset() {
+	*x = FieldError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portentries_portentries_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,13 +994,13 @@ func (x *PortResponse) Reset() {
 	}
 }
 
-func (x *PortResponse) String() string {
+func (x *FieldError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortResponse) ProtoMessage() {}
+func (*FieldError) ProtoMessage() {}
 
-func (x *PortResponse) ProtoReflect() protoreflect.Message {
+func (x *FieldError) ProtoReflect() protoreflect.Message {
 	mi := &file_portentries_portentries_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -509,43 +1012,44 @@ func (x *PortResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortResponse.ProtoReflect.Descriptor instead.
-func (*PortResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FieldError.ProtoReflect.Descriptor instead.
+func (*FieldError) Descriptor() ([]byte, []int) {
 	return file_portentries_portentries_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PortResponse) GetStatusCode() int64 {
+func (x *FieldError) GetField() string {
 	if x != nil {
-		return x.StatusCode
+		return x.Field
 	}
-	return 0
+	return ""
 }
 
-func (x *PortResponse) GetMessage() string {
+func (x *FieldError) GetReason() string {
 	if x != nil {
-		return x.Message
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *PortResponse) GetData() *Port {
+func (x *FieldError) GetRule() string {
 	if x != nil {
-		return x.Data
+		return x.Rule
 	}
-	return nil
+	return ""
 }
 
-type PortRequest struct {
+// FieldErrors are attached to InvalidArgument statuses next to the BadRequest details,
+// they tell the rule every invalid field of the request breaks
+type FieldErrors struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   *wrappers.Int64Value  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Slug *wrappers.StringValue `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Errors []*FieldError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *PortRequest) Reset() {
-	*x = PortRequest{}
+func (x *FieldErrors) Reset() {
+	*x = FieldErrors{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portentries_portentries_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,13 +1057,13 @@ func (x *PortRequest) Reset() {
 	}
 }
 
-func (x *PortRequest) String() string {
+func (x *FieldErrors) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortRequest) ProtoMessage() {}
+func (*FieldErrors) ProtoMessage() {}
 
-func (x *PortRequest) ProtoReflect() protoreflect.Message {
+func (x *FieldErrors) ProtoReflect() protoreflect.Message {
 	mi := &file_portentries_portentries_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -571,37 +1075,32 @@ func (x *PortRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortRequest.ProtoReflect.Descriptor instead.
-func (*PortRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FieldErrors.ProtoReflect.Descriptor instead.
+func (*FieldErrors) Descriptor() ([]byte, []int) {
 	return file_portentries_portentries_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PortRequest) GetId() *wrappers.Int64Value {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
-
-func (x *PortRequest) GetSlug() *wrappers.StringValue {
+func (x *FieldErrors) GetErrors() []*FieldError {
 	if x != nil {
-		return x.Slug
+		return x.Errors
 	}
 	return nil
 }
 
-type UpdatePortRequest struct {
+// Conflicts is the conflict policy of the upsert, field_policies override it for single fields
+type Conflicts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   *wrappers.Int64Value  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Slug *wrappers.StringValue `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	Data *PortUpdatable        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Policy ConflictPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=ports.ConflictPolicy" json:"policy,omitempty"`
+	// field_policies are keyed by the port fields, one of code, name, city, province, country, alias, regions,
+	// coordinates, which stand for both lat and lng, timezone or unlocks
+	FieldPolicies map[string]ConflictPolicy `protobuf:"bytes,2,rep,name=field_policies,json=fieldPolicies,proto3" json:"field_policies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=ports.ConflictPolicy"`
 }
 
-func (x *UpdatePortRequest) Reset() {
-	*x = UpdatePortRequest{}
+func (x *Conflicts) Reset() {
+	*x = Conflicts{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portentries_portentries_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -609,13 +1108,13 @@ func (x *UpdatePortRequest) Reset() {
 	}
 }
 
-func (x *UpdatePortRequest) String() string {
+func (x *Conflicts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePortRequest) ProtoMessage() {}
+func (*Conflicts) ProtoMessage() {}
 
-func (x *UpdatePortRequest) ProtoReflect() protoreflect.Message {
+func (x *Conflicts) ProtoReflect() protoreflect.Message {
 	mi := &file_portentries_portentries_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -627,52 +1126,35 @@ func (x *UpdatePortRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePortRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePortRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Conflicts.ProtoReflect.Descriptor instead.
+func (*Conflicts) Descriptor() ([]byte, []int) {
 	return file_portentries_portentries_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdatePortRequest) GetId() *wrappers.Int64Value {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
-
-func (x *UpdatePortRequest) GetSlug() *wrappers.StringValue {
+func (x *Conflicts) GetPolicy() ConflictPolicy {
 	if x != nil {
-		return x.Slug
+		return x.Policy
 	}
-	return nil
+	return ConflictPolicy_CONFLICT_POLICY_OVERWRITE
 }
 
-func (x *UpdatePortRequest) GetData() *PortUpdatable {
+func (x *Conflicts) GetFieldPolicies() map[string]ConflictPolicy {
 	if x != nil {
-		return x.Data
+		return x.FieldPolicies
 	}
 	return nil
 }
 
-type PortUpdatable struct {
+type StartPortsImportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     *wrappers.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	City     *wrappers.StringValue `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
-	Province *wrappers.StringValue `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
-	Country  *wrappers.StringValue `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
-	Alias    []string              `protobuf:"bytes,7,rep,name=alias,proto3" json:"alias,omitempty"`
-	// TODO find out what type this field has. Assuming array of strings as alias
-	Regions     []string              `protobuf:"bytes,8,rep,name=regions,proto3" json:"regions,omitempty"`
-	Coordinates *Coordinates          `protobuf:"bytes,9,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
-	Timezone    *wrappers.StringValue `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	Unlocks     []string              `protobuf:"bytes,11,rep,name=unlocks,proto3" json:"unlocks,omitempty"`
-	Code        *wrappers.StringValue `protobuf:"bytes,12,opt,name=code,proto3" json:"code,omitempty"`
+	Loader ImportLoader `protobuf:"varint,1,opt,name=loader,proto3,enum=ports.ImportLoader" json:"loader,omitempty"`
 }
 
-func (x *PortUpdatable) Reset() {
-	*x = PortUpdatable{}
+func (x *StartPortsImportRequest) Reset() {
+	*x = StartPortsImportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_portentries_portentries_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -680,13 +1162,13 @@ func (x *PortUpdatable) Reset() {
 	}
 }
 
-func (x *PortUpdatable) String() string {
+func (x *StartPortsImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortUpdatable) ProtoMessage() {}
+func (*StartPortsImportRequest) ProtoMessage() {}
 
-func (x *PortUpdatable) ProtoReflect() protoreflect.Message {
+func (x *StartPortsImportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_portentries_portentries_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -698,226 +1180,3582 @@ func (x *PortUpdatable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortUpdatable.ProtoReflect.Descriptor instead.
-func (*PortUpdatable) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartPortsImportRequest.ProtoReflect.Descriptor instead.
+func (*StartPortsImportRequest) Descriptor() ([]byte, []int) {
 	return file_portentries_portentries_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PortUpdatable) GetName() *wrappers.StringValue {
+func (x *StartPortsImportRequest) GetLoader() ImportLoader {
 	if x != nil {
-		return x.Name
+		return x.Loader
 	}
-	return nil
+	return ImportLoader_IMPORT_LOADER_INSERT
 }
 
-func (x *PortUpdatable) GetCity() *wrappers.StringValue {
-	if x != nil {
-		return x.City
-	}
-	return nil
+type PortsImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportId string `protobuf:"bytes,1,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
 }
 
-func (x *PortUpdatable) GetProvince() *wrappers.StringValue {
-	if x != nil {
-		return x.Province
+func (x *PortsImportRequest) Reset() {
+	*x = PortsImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *PortUpdatable) GetCountry() *wrappers.StringValue {
-	if x != nil {
-		return x.Country
+func (x *PortsImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortsImportRequest) ProtoMessage() {}
+
+func (x *PortsImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PortUpdatable) GetAlias() []string {
+// Deprecated: Use PortsImportRequest.ProtoReflect.Descriptor instead.
+func (*PortsImportRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PortsImportRequest) GetImportId() string {
 	if x != nil {
-		return x.Alias
+		return x.ImportId
 	}
-	return nil
+	return ""
 }
 
-func (x *PortUpdatable) GetRegions() []string {
-	if x != nil {
-		return x.Regions
+type PortsImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ImportId   string `protobuf:"bytes,3,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+}
+
+func (x *PortsImportResponse) Reset() {
+	*x = PortsImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *PortUpdatable) GetCoordinates() *Coordinates {
-	if x != nil {
-		return x.Coordinates
+func (x *PortsImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortsImportResponse) ProtoMessage() {}
+
+func (x *PortsImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PortUpdatable) GetTimezone() *wrappers.StringValue {
+// Deprecated: Use PortsImportResponse.ProtoReflect.Descriptor instead.
+func (*PortsImportResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PortsImportResponse) GetStatusCode() int64 {
 	if x != nil {
-		return x.Timezone
+		return x.StatusCode
 	}
-	return nil
+	return 0
 }
 
-func (x *PortUpdatable) GetUnlocks() []string {
+func (x *PortsImportResponse) GetMessage() string {
 	if x != nil {
-		return x.Unlocks
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *PortUpdatable) GetCode() *wrappers.StringValue {
+func (x *PortsImportResponse) GetImportId() string {
 	if x != nil {
-		return x.Code
+		return x.ImportId
 	}
-	return nil
+	return ""
 }
 
-var File_portentries_portentries_proto protoreflect.FileDescriptor
+type StagePortsImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_portentries_portentries_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x40, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x42,
-	0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x4a, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x44, 0x0a,
-	0x15, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72,
-	0x74, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	ImportId string `protobuf:"bytes,1,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	// data staged with the slug already staged by the import replaces it,
+	// invalid ports are not staged and reported in the response
+	Data []*Port `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *StagePortsImportRequest) Reset() {
+	*x = StagePortsImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StagePortsImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StagePortsImportRequest) ProtoMessage() {}
+
+func (x *StagePortsImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StagePortsImportRequest.ProtoReflect.Descriptor instead.
+func (*StagePortsImportRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StagePortsImportRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *StagePortsImportRequest) GetData() []*Port {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StagePortsImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// failed lists the ports which were not staged
+	Failed []*PortResult `protobuf:"bytes,3,rep,name=failed,proto3" json:"failed,omitempty"`
+	// warned lists the staged ports breaking the domain rules in lenient validation mode
+	Warned []*PortResult `protobuf:"bytes,4,rep,name=warned,proto3" json:"warned,omitempty"`
+}
+
+func (x *StagePortsImportResponse) Reset() {
+	*x = StagePortsImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StagePortsImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StagePortsImportResponse) ProtoMessage() {}
+
+func (x *StagePortsImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StagePortsImportResponse.ProtoReflect.Descriptor instead.
+func (*StagePortsImportResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StagePortsImportResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *StagePortsImportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StagePortsImportResponse) GetFailed() []*PortResult {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+func (x *StagePortsImportResponse) GetWarned() []*PortResult {
+	if x != nil {
+		return x.Warned
+	}
+	return nil
+}
+
+type FinishPortsImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportId string     `protobuf:"bytes,1,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	Mode     ImportMode `protobuf:"varint,2,opt,name=mode,proto3,enum=ports.ImportMode" json:"mode,omitempty"`
+	// dry_run only counts the changes the import would make
+	DryRun    bool       `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Conflicts *Conflicts `protobuf:"bytes,4,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *FinishPortsImportRequest) Reset() {
+	*x = FinishPortsImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishPortsImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPortsImportRequest) ProtoMessage() {}
+
+func (x *FinishPortsImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPortsImportRequest.ProtoReflect.Descriptor instead.
+func (*FinishPortsImportRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FinishPortsImportRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *FinishPortsImportRequest) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UPSERT
+}
+
+func (x *FinishPortsImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *FinishPortsImportRequest) GetConflicts() *Conflicts {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type PortsImportSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Created    int64  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated    int64  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged  int64  `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Removed    int64  `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+	DryRun     bool   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PortsImportSummary) Reset() {
+	*x = PortsImportSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortsImportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortsImportSummary) ProtoMessage() {}
+
+func (x *PortsImportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortsImportSummary.ProtoReflect.Descriptor instead.
+func (*PortsImportSummary) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PortsImportSummary) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *PortsImportSummary) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PortsImportSummary) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *PortsImportSummary) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *PortsImportSummary) GetUnchanged() int64 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *PortsImportSummary) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *PortsImportSummary) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status   ImportJobStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ports.ImportJobStatus" json:"status,omitempty"`
+	Mode     ImportMode      `protobuf:"varint,3,opt,name=mode,proto3,enum=ports.ImportMode" json:"mode,omitempty"`
+	DryRun   bool            `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	FileName string          `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// actor is the one who has created the job, the ports changes are recorded in its name
+	Actor string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	// import_id is the import the job stages the ports in
+	ImportId  string `protobuf:"bytes,7,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	Processed int64  `protobuf:"varint,8,opt,name=processed,proto3" json:"processed,omitempty"`
+	Failed    int64  `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`
+	// errors tell why the job failed
+	Errors []string `protobuf:"bytes,10,rep,name=errors,proto3" json:"errors,omitempty"`
+	// summary is set once the job has succeeded
+	Summary    *PortsImportSummary  `protobuf:"bytes,11,opt,name=summary,proto3" json:"summary,omitempty"`
+	CreatedAt  *timestamp.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamp.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// record_errors tell why the failed records of the file were not imported, only the first 100 are kept
+	RecordErrors []*RecordError `protobuf:"bytes,15,rep,name=record_errors,json=recordErrors,proto3" json:"record_errors,omitempty"`
+	Format       ImportFormat   `protobuf:"varint,16,opt,name=format,proto3,enum=ports.ImportFormat" json:"format,omitempty"`
+	// slug_property is the property of the ports the slugs are read from, unless the format is object
+	SlugProperty string       `protobuf:"bytes,17,opt,name=slug_property,json=slugProperty,proto3" json:"slug_property,omitempty"`
+	Loader       ImportLoader `protobuf:"varint,18,opt,name=loader,proto3,enum=ports.ImportLoader" json:"loader,omitempty"`
+	Conflicts    *Conflicts   `protobuf:"bytes,19,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// warned counts the imported records breaking the domain rules in lenient validation mode
+	Warned int64 `protobuf:"varint,20,opt,name=warned,proto3" json:"warned,omitempty"`
+	// record_warnings tell which domain rules the warned records break, only the first 100 are kept
+	RecordWarnings []*RecordError `protobuf:"bytes,21,rep,name=record_warnings,json=recordWarnings,proto3" json:"record_warnings,omitempty"`
+}
+
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJob) ProtoMessage() {}
+
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJob.ProtoReflect.Descriptor instead.
+func (*ImportJob) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportJob) GetStatus() ImportJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportJobStatus_IMPORT_JOB_STATUS_PENDING
+}
+
+func (x *ImportJob) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UPSERT
+}
+
+func (x *ImportJob) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportJob) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportJob) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ImportJob) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *ImportJob) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportJob) GetSummary() *PortsImportSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *ImportJob) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetFinishedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetRecordErrors() []*RecordError {
+	if x != nil {
+		return x.RecordErrors
+	}
+	return nil
+}
+
+func (x *ImportJob) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_AUTO
+}
+
+func (x *ImportJob) GetSlugProperty() string {
+	if x != nil {
+		return x.SlugProperty
+	}
+	return ""
+}
+
+func (x *ImportJob) GetLoader() ImportLoader {
+	if x != nil {
+		return x.Loader
+	}
+	return ImportLoader_IMPORT_LOADER_INSERT
+}
+
+func (x *ImportJob) GetConflicts() *Conflicts {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ImportJob) GetWarned() int64 {
+	if x != nil {
+		return x.Warned
+	}
+	return 0
+}
+
+func (x *ImportJob) GetRecordWarnings() []*RecordError {
+	if x != nil {
+		return x.RecordWarnings
+	}
+	return nil
+}
+
+// RecordError is a record of the imported file which failed
+type RecordError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the json key of the record
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// offset is the byte offset of the record in the file
+	Offset int64         `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Errors []*FieldError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	// file is the file of the uploaded zip archive the record is in, empty for other uploads
+	File string `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+}
+
+func (x *RecordError) Reset() {
+	*x = RecordError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordError) ProtoMessage() {}
+
+func (x *RecordError) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordError.ProtoReflect.Descriptor instead.
+func (*RecordError) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecordError) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RecordError) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *RecordError) GetErrors() []*FieldError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *RecordError) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type CreateImportJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode         ImportMode   `protobuf:"varint,1,opt,name=mode,proto3,enum=ports.ImportMode" json:"mode,omitempty"`
+	DryRun       bool         `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	FileName     string       `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Format       ImportFormat `protobuf:"varint,4,opt,name=format,proto3,enum=ports.ImportFormat" json:"format,omitempty"`
+	SlugProperty string       `protobuf:"bytes,5,opt,name=slug_property,json=slugProperty,proto3" json:"slug_property,omitempty"`
+	Loader       ImportLoader `protobuf:"varint,6,opt,name=loader,proto3,enum=ports.ImportLoader" json:"loader,omitempty"`
+	Conflicts    *Conflicts   `protobuf:"bytes,7,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *CreateImportJobRequest) Reset() {
+	*x = CreateImportJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImportJobRequest) ProtoMessage() {}
+
+func (x *CreateImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImportJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateImportJobRequest) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UPSERT
+}
+
+func (x *CreateImportJobRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateImportJobRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CreateImportJobRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_AUTO
+}
+
+func (x *CreateImportJobRequest) GetSlugProperty() string {
+	if x != nil {
+		return x.SlugProperty
+	}
+	return ""
+}
+
+func (x *CreateImportJobRequest) GetLoader() ImportLoader {
+	if x != nil {
+		return x.Loader
+	}
+	return ImportLoader_IMPORT_LOADER_INSERT
+}
+
+func (x *CreateImportJobRequest) GetConflicts() *Conflicts {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type UpdateImportJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status    ImportJobStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ports.ImportJobStatus" json:"status,omitempty"`
+	ImportId  string          `protobuf:"bytes,3,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	Processed int64           `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`
+	Failed    int64           `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// errors and record_errors are appended to the ones of the job
+	Errors       []string            `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	Summary      *PortsImportSummary `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	RecordErrors []*RecordError      `protobuf:"bytes,8,rep,name=record_errors,json=recordErrors,proto3" json:"record_errors,omitempty"`
+	Warned       int64               `protobuf:"varint,9,opt,name=warned,proto3" json:"warned,omitempty"`
+	// record_warnings are appended to the ones of the job as well
+	RecordWarnings []*RecordError `protobuf:"bytes,10,rep,name=record_warnings,json=recordWarnings,proto3" json:"record_warnings,omitempty"`
+}
+
+func (x *UpdateImportJobRequest) Reset() {
+	*x = UpdateImportJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateImportJobRequest) ProtoMessage() {}
+
+func (x *UpdateImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateImportJobRequest.ProtoReflect.Descriptor instead.
+func (*UpdateImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateImportJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateImportJobRequest) GetStatus() ImportJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportJobStatus_IMPORT_JOB_STATUS_PENDING
+}
+
+func (x *UpdateImportJobRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *UpdateImportJobRequest) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *UpdateImportJobRequest) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *UpdateImportJobRequest) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *UpdateImportJobRequest) GetSummary() *PortsImportSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *UpdateImportJobRequest) GetRecordErrors() []*RecordError {
+	if x != nil {
+		return x.RecordErrors
+	}
+	return nil
+}
+
+func (x *UpdateImportJobRequest) GetWarned() int64 {
+	if x != nil {
+		return x.Warned
+	}
+	return 0
+}
+
+func (x *UpdateImportJobRequest) GetRecordWarnings() []*RecordError {
+	if x != nil {
+		return x.RecordWarnings
+	}
+	return nil
+}
+
+type ImportJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ImportJobRequest) Reset() {
+	*x = ImportJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobRequest) ProtoMessage() {}
+
+func (x *ImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobRequest.ProtoReflect.Descriptor instead.
+func (*ImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ImportJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64      `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data       *ImportJob `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ImportJobResponse) Reset() {
+	*x = ImportJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobResponse) ProtoMessage() {}
+
+func (x *ImportJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobResponse.ProtoReflect.Descriptor instead.
+func (*ImportJobResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportJobResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ImportJobResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportJobResponse) GetData() *ImportJob {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListImportJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// page_size limits the number of returned jobs, defaults to 100 when empty
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token value of the previous ListImportJobsResponse
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// status filters the jobs by any of the given statuses
+	Status []ImportJobStatus `protobuf:"varint,3,rep,packed,name=status,proto3,enum=ports.ImportJobStatus" json:"status,omitempty"`
+}
+
+func (x *ListImportJobsRequest) Reset() {
+	*x = ListImportJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImportJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportJobsRequest) ProtoMessage() {}
+
+func (x *ListImportJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListImportJobsRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListImportJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListImportJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListImportJobsRequest) GetStatus() []ImportJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListImportJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// data lists the newest jobs first
+	Data []*ImportJob `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// next_page_token is empty when there are no more jobs to fetch
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListImportJobsResponse) Reset() {
+	*x = ListImportJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImportJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportJobsResponse) ProtoMessage() {}
+
+func (x *ListImportJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListImportJobsResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListImportJobsResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListImportJobsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListImportJobsResponse) GetData() []*ImportJob {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListImportJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListPortsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// page_size limits the number of returned ports, defaults to 100 when empty
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token value of the previous ListPortsResponse
+	PageToken  string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Country    string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Province   string `protobuf:"bytes,4,opt,name=province,proto3" json:"province,omitempty"`
+	Region     string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	Timezone   string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CodePrefix string `protobuf:"bytes,7,opt,name=code_prefix,json=codePrefix,proto3" json:"code_prefix,omitempty"`
+	OrderBy    string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Descending bool   `protobuf:"varint,9,opt,name=descending,proto3" json:"descending,omitempty"`
+	// as_of lists the ports as they were at the given time
+	AsOf *timestamp.Timestamp `protobuf:"bytes,10,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *ListPortsRequest) Reset() {
+	*x = ListPortsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortsRequest) ProtoMessage() {}
+
+func (x *ListPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortsRequest.ProtoReflect.Descriptor instead.
+func (*ListPortsRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPortsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPortsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPortsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ListPortsRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *ListPortsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListPortsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ListPortsRequest) GetCodePrefix() string {
+	if x != nil {
+		return x.CodePrefix
+	}
+	return ""
+}
+
+func (x *ListPortsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPortsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListPortsRequest) GetAsOf() *timestamp.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// ExportPortsRequest has the filters and the order of ListPortsRequest, every matching port is exported
+type ExportPortsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Country    string `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Province   string `protobuf:"bytes,2,opt,name=province,proto3" json:"province,omitempty"`
+	Region     string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Timezone   string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CodePrefix string `protobuf:"bytes,5,opt,name=code_prefix,json=codePrefix,proto3" json:"code_prefix,omitempty"`
+	OrderBy    string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Descending bool   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	// as_of exports the ports as they were at the given time
+	AsOf *timestamp.Timestamp `protobuf:"bytes,8,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *ExportPortsRequest) Reset() {
+	*x = ExportPortsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPortsRequest) ProtoMessage() {}
+
+func (x *ExportPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPortsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPortsRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportPortsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ExportPortsRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *ExportPortsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ExportPortsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ExportPortsRequest) GetCodePrefix() string {
+	if x != nil {
+		return x.CodePrefix
+	}
+	return ""
+}
+
+func (x *ExportPortsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ExportPortsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ExportPortsRequest) GetAsOf() *timestamp.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type ExportPortsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Port `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportPortsResponse) Reset() {
+	*x = ExportPortsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPortsResponse) ProtoMessage() {}
+
+func (x *ExportPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPortsResponse.ProtoReflect.Descriptor instead.
+func (*ExportPortsResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportPortsResponse) GetData() []*Port {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListPortsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64   `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data       []*Port `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// next_page_token is empty when there are no more ports to fetch
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListPortsResponse) Reset() {
+	*x = ListPortsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortsResponse) ProtoMessage() {}
+
+func (x *ListPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortsResponse.ProtoReflect.Descriptor instead.
+func (*ListPortsResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListPortsResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ListPortsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPortsResponse) GetData() []*Port {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListPortsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Port struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug     string               `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Id       *wrappers.Int64Value `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	City     string               `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Province string               `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
+	Country  string               `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	Alias    []string             `protobuf:"bytes,7,rep,name=alias,proto3" json:"alias,omitempty"`
+	// TODO find out what type this field has. Assuming array of strings as alias
+	Regions     []string     `protobuf:"bytes,8,rep,name=regions,proto3" json:"regions,omitempty"`
+	Coordinates *Coordinates `protobuf:"bytes,9,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Timezone    string       `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Unlocks     []string     `protobuf:"bytes,11,rep,name=unlocks,proto3" json:"unlocks,omitempty"`
+	Code        string       `protobuf:"bytes,12,opt,name=code,proto3" json:"code,omitempty"`
+	// version is incremented on every change of the port, it is ignored on create and upsert
+	Version int64 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Port) Reset() {
+	*x = Port{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port) ProtoMessage() {}
+
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Port) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Port) GetId() *wrappers.Int64Value {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Port) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Port) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Port) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *Port) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Port) GetAlias() []string {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
+func (x *Port) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *Port) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *Port) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Port) GetUnlocks() []string {
+	if x != nil {
+		return x.Unlocks
+	}
+	return nil
+}
+
+func (x *Port) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Port) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Coordinates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lng float64 `protobuf:"fixed64,1,opt,name=lng,proto3" json:"lng,omitempty"`
+	Lat float64 `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+}
+
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coordinates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinates) ProtoMessage() {}
+
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Coordinates) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+func (x *Coordinates) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+type PortResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data       *Port  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// warnings tell which domain rules the stored port breaks in lenient validation mode
+	Warnings []*FieldError `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *PortResponse) Reset() {
+	*x = PortResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortResponse) ProtoMessage() {}
+
+func (x *PortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortResponse.ProtoReflect.Descriptor instead.
+func (*PortResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PortResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *PortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PortResponse) GetData() *Port {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PortResponse) GetWarnings() []*FieldError {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type NearestPortsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *Coordinates `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// radius_km limits the search area, 0 means the whole globe
+	RadiusKm float64 `protobuf:"fixed64,2,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	// limit is the number of ports to return, defaults to 10 when empty
+	Limit   int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Country string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+}
+
+func (x *NearestPortsRequest) Reset() {
+	*x = NearestPortsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearestPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestPortsRequest) ProtoMessage() {}
+
+func (x *NearestPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestPortsRequest.ProtoReflect.Descriptor instead.
+func (*NearestPortsRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *NearestPortsRequest) GetLocation() *Coordinates {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NearestPortsRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *NearestPortsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *NearestPortsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type NearestPortsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64           `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data       []*PortDistance `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *NearestPortsResponse) Reset() {
+	*x = NearestPortsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearestPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestPortsResponse) ProtoMessage() {}
+
+func (x *NearestPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestPortsResponse.ProtoReflect.Descriptor instead.
+func (*NearestPortsResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *NearestPortsResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *NearestPortsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NearestPortsResponse) GetData() []*PortDistance {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PortDistance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port       *Port   `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	DistanceKm float64 `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+}
+
+func (x *PortDistance) Reset() {
+	*x = PortDistance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortDistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortDistance) ProtoMessage() {}
+
+func (x *PortDistance) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortDistance.ProtoReflect.Descriptor instead.
+func (*PortDistance) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PortDistance) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *PortDistance) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type SearchPortsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// limit is the number of ports to return, defaults to 20 when empty
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchPortsRequest) Reset() {
+	*x = SearchPortsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPortsRequest) ProtoMessage() {}
+
+func (x *SearchPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPortsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPortsRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchPortsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchPortsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchPortsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64        `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data       []*PortMatch `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SearchPortsResponse) Reset() {
+	*x = SearchPortsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPortsResponse) ProtoMessage() {}
+
+func (x *SearchPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPortsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPortsResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SearchPortsResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *SearchPortsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchPortsResponse) GetData() []*PortMatch {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PortMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port *Port `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	// score is the match relevance between 0 and 1
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *PortMatch) Reset() {
+	*x = PortMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortMatch) ProtoMessage() {}
+
+func (x *PortMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortMatch.ProtoReflect.Descriptor instead.
+func (*PortMatch) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PortMatch) GetPort() *Port {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *PortMatch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// PortRequest identifies the port by id or slug, one of them is required
+type PortRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   *wrappers.Int64Value  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug *wrappers.StringValue `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	// as_of fetches the port as it was at the given time
+	AsOf *timestamp.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// expected_version makes DeletePort fail when the port version differs
+	ExpectedVersion *wrappers.Int64Value `protobuf:"bytes,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *PortRequest) Reset() {
+	*x = PortRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortRequest) ProtoMessage() {}
+
+func (x *PortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortRequest.ProtoReflect.Descriptor instead.
+func (*PortRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PortRequest) GetId() *wrappers.Int64Value {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PortRequest) GetSlug() *wrappers.StringValue {
+	if x != nil {
+		return x.Slug
+	}
+	return nil
+}
+
+func (x *PortRequest) GetAsOf() *timestamp.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *PortRequest) GetExpectedVersion() *wrappers.Int64Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+// PortKey identifies the port by its id, slug or any of its unlocks
+type PortKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*PortKey_Id
+	//	*PortKey_Slug
+	//	*PortKey_Unlock
+	Key isPortKey_Key `protobuf_oneof:"key"`
+}
+
+func (x *PortKey) Reset() {
+	*x = PortKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortKey) ProtoMessage() {}
+
+func (x *PortKey) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortKey.ProtoReflect.Descriptor instead.
+func (*PortKey) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{39}
+}
+
+func (m *PortKey) GetKey() isPortKey_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *PortKey) GetId() int64 {
+	if x, ok := x.GetKey().(*PortKey_Id); ok {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PortKey) GetSlug() string {
+	if x, ok := x.GetKey().(*PortKey_Slug); ok {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PortKey) GetUnlock() string {
+	if x, ok := x.GetKey().(*PortKey_Unlock); ok {
+		return x.Unlock
+	}
+	return ""
+}
+
+type isPortKey_Key interface {
+	isPortKey_Key()
+}
+
+type PortKey_Id struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type PortKey_Slug struct {
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3,oneof"`
+}
+
+type PortKey_Unlock struct {
+	Unlock string `protobuf:"bytes,3,opt,name=unlock,proto3,oneof"`
+}
+
+func (*PortKey_Id) isPortKey_Key() {}
+
+func (*PortKey_Slug) isPortKey_Key() {}
+
+func (*PortKey_Unlock) isPortKey_Key() {}
+
+type BatchGetPortsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keys are checked one by one, the blank or malformed ones are reported in not_found instead of failing the request
+	Keys []*PortKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *BatchGetPortsRequest) Reset() {
+	*x = BatchGetPortsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPortsRequest) ProtoMessage() {}
+
+func (x *BatchGetPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPortsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPortsRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchGetPortsRequest) GetKeys() []*PortKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type BatchGetPortsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data are the found ports in the order of the keys, the port found by several keys is listed once
+	Data []*Port `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// not_found are the keys no port was found by, in the order of the request
+	NotFound []*PortKey `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+}
+
+func (x *BatchGetPortsResponse) Reset() {
+	*x = BatchGetPortsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPortsResponse) ProtoMessage() {}
+
+func (x *BatchGetPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPortsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPortsResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchGetPortsResponse) GetData() []*Port {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BatchGetPortsResponse) GetNotFound() []*PortKey {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+// PortHistoryRequest identifies the port by id or slug, one of them is required
+type PortHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   *wrappers.Int64Value  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug *wrappers.StringValue `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+func (x *PortHistoryRequest) Reset() {
+	*x = PortHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortHistoryRequest) ProtoMessage() {}
+
+func (x *PortHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PortHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PortHistoryRequest) GetId() *wrappers.Int64Value {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PortHistoryRequest) GetSlug() *wrappers.StringValue {
+	if x != nil {
+		return x.Slug
+	}
+	return nil
+}
+
+type PortHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int64         `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Message    string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data       []*PortChange `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PortHistoryResponse) Reset() {
+	*x = PortHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortHistoryResponse) ProtoMessage() {}
+
+func (x *PortHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PortHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PortHistoryResponse) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *PortHistoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PortHistoryResponse) GetData() []*PortChange {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PortChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// operation is one of create, update or delete
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// old_value is empty for create
+	OldValue *Port `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value is empty for delete
+	NewValue  *Port                `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	Actor     string               `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	ChangedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+}
+
+func (x *PortChange) Reset() {
+	*x = PortChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortChange) ProtoMessage() {}
+
+func (x *PortChange) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortChange.ProtoReflect.Descriptor instead.
+func (*PortChange) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PortChange) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PortChange) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PortChange) GetOldValue() *Port {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *PortChange) GetNewValue() *Port {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+func (x *PortChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PortChange) GetChangedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type UpdatePortRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   *wrappers.Int64Value  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug *wrappers.StringValue `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Data *PortUpdatable        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// expected_version makes UpdatePort fail when the port version differs
+	ExpectedVersion *wrappers.Int64Value `protobuf:"bytes,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// update_mask lists the fields to update, like province or coordinates, the listed fields missing from data are cleared,
+	// without it only the fields set in data are updated and none can be cleared
+	UpdateMask *field_mask.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdatePortRequest) Reset() {
+	*x = UpdatePortRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePortRequest) ProtoMessage() {}
+
+func (x *UpdatePortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePortRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePortRequest) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdatePortRequest) GetId() *wrappers.Int64Value {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdatePortRequest) GetSlug() *wrappers.StringValue {
+	if x != nil {
+		return x.Slug
+	}
+	return nil
+}
+
+func (x *UpdatePortRequest) GetData() *PortUpdatable {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdatePortRequest) GetExpectedVersion() *wrappers.Int64Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+func (x *UpdatePortRequest) GetUpdateMask() *field_mask.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type PortUpdatable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     *wrappers.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	City     *wrappers.StringValue `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Province *wrappers.StringValue `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`
+	Country  *wrappers.StringValue `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	Alias    []string              `protobuf:"bytes,7,rep,name=alias,proto3" json:"alias,omitempty"`
+	// TODO find out what type this field has. Assuming array of strings as alias
+	Regions     []string              `protobuf:"bytes,8,rep,name=regions,proto3" json:"regions,omitempty"`
+	Coordinates *Coordinates          `protobuf:"bytes,9,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Timezone    *wrappers.StringValue `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Unlocks     []string              `protobuf:"bytes,11,rep,name=unlocks,proto3" json:"unlocks,omitempty"`
+	Code        *wrappers.StringValue `protobuf:"bytes,12,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *PortUpdatable) Reset() {
+	*x = PortUpdatable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_portentries_portentries_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortUpdatable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortUpdatable) ProtoMessage() {}
+
+func (x *PortUpdatable) ProtoReflect() protoreflect.Message {
+	mi := &file_portentries_portentries_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortUpdatable.ProtoReflect.Descriptor instead.
+func (*PortUpdatable) Descriptor() ([]byte, []int) {
+	return file_portentries_portentries_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PortUpdatable) GetName() *wrappers.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetCity() *wrappers.StringValue {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetProvince() *wrappers.StringValue {
+	if x != nil {
+		return x.Province
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetCountry() *wrappers.StringValue {
+	if x != nil {
+		return x.Country
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetAlias() []string {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetTimezone() *wrappers.StringValue {
+	if x != nil {
+		return x.Timezone
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetUnlocks() []string {
+	if x != nil {
+		return x.Unlocks
+	}
+	return nil
+}
+
+func (x *PortUpdatable) GetCode() *wrappers.StringValue {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+var File_portentries_portentries_proto protoreflect.FileDescriptor
+
+var file_portentries_portentries_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x70, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xa7, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x42,
+	0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x11, 0xba, 0xe9, 0xc0, 0x03, 0x0c, 0x92, 0x01, 0x09, 0x08,
+	0x01, 0x22, 0x05, 0x8a, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x65, 0x4f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x16, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x81, 0x02, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x0f, 0xba, 0xe9, 0xc0,
+	0x03, 0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x8a, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0xad, 0x03, 0x0a, 0x19,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77,
+	0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b,
+	0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xd0, 0x02, 0x0a, 0x0a,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x62, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x05, 0x22, 0x4e,
+	0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x38,
+	0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0a, 0xba,
+	0xe9, 0xc0, 0x03, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x5b, 0x0a, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0f,
+	0xba, 0xe9, 0xc0, 0x03, 0x0a, 0x9a, 0x01, 0x07, 0x2a, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x57,
+	0x0a, 0x12, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x12, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0xe9, 0xc0, 0x03, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x13, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0xe9, 0xc0, 0x03, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x11, 0xba, 0xe9, 0xc0, 0x03, 0x0c, 0x92, 0x01, 0x09,
+	0x08, 0x01, 0x22, 0x05, 0x8a, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xab, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
 	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f,
-	0x72, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xef, 0x02, 0x0a, 0x04, 0x50, 0x6f, 0x72,
-	0x74, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xba, 0xe9, 0xc0, 0x03, 0x13, 0x72, 0x11, 0x10, 0x05, 0x18, 0x05, 0x32, 0x0b, 0x5e, 0x5b,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12,
-	0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
-	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x67, 0x0a, 0x0b, 0x43, 0x6f,
-	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x6c, 0x6e, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x19, 0xba, 0xe9, 0xc0, 0x03, 0x14, 0x12, 0x12, 0x19,
-	0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66,
-	0xc0, 0x52, 0x03, 0x6c, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x42, 0x19, 0xba, 0xe9, 0xc0, 0x03, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00,
-	0x00, 0x00, 0x80, 0x56, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x52, 0x03,
-	0x6c, 0x61, 0x74, 0x22, 0x6a, 0x0a, 0x0c, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x22, 0xbe, 0x01,
+	0x0a, 0x18, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
+	0xe9, 0xc0, 0x03, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x2e,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0xd4,
+	0x01, 0x0a, 0x12, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xce, 0x06, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6c, 0x75, 0x67, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x76, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x29, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xdf,
+	0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x72,
+	0x03, 0x18, 0xff, 0x01, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x0d, 0x73, 0x6c, 0x75, 0x67, 0x5f,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xba, 0xe9, 0xc0, 0x03, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x0c, 0x73, 0x6c, 0x75, 0x67, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x42, 0x0a, 0xba, 0xe9,
+	0xc0, 0x03, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x22, 0xbe, 0x03, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xba, 0xe9, 0xc0, 0x03, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a,
+	0xba, 0xe9, 0xc0, 0x03, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x09, 0xba, 0xe9, 0xc0, 0x03, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xba, 0xe9, 0xc0, 0x03, 0x04, 0x22,
+	0x02, 0x28, 0x00, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x21, 0x0a, 0x06, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x09, 0xba, 0xe9, 0xc0, 0x03, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x77, 0x61,
+	0x72, 0x6e, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x2d, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x09, 0xba, 0xe9, 0xc0, 0x03, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x74, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa2, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0c, 0xba, 0xe9, 0xc0, 0x03, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28,
+	0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x0f, 0xba, 0xe9, 0xc0, 0x03, 0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xfd, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0c, 0xba, 0xe9, 0xc0, 0x03, 0x07, 0x1a, 0x05,
+	0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x64, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x43, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xba, 0xe9, 0xc0,
+	0x03, 0x23, 0x72, 0x21, 0x52, 0x00, 0x52, 0x02, 0x69, 0x64, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2f,
+	0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22,
+	0xb5, 0x02, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x43, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x28, 0xba, 0xe9, 0xc0, 0x03, 0x23, 0x72, 0x21, 0x52, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04,
+	0x63, 0x69, 0x74, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x36, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
 	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x83, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x04,
-	0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x15, 0xba, 0xe9, 0xc0, 0x03, 0x10, 0x72,
-	0x0e, 0x10, 0x05, 0x18, 0x05, 0x32, 0x08, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x2b, 0x24, 0x52,
-	0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x15, 0xba, 0xe9, 0xc0, 0x03, 0x10, 0x72, 0x0e, 0x10, 0x05, 0x18,
-	0x05, 0x32, 0x08, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6c, 0x75,
+	0x97, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x89, 0x03, 0x0a, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xba, 0xe9, 0xc0, 0x03, 0x13, 0x72, 0x11, 0x10, 0x05, 0x18, 0x05, 0x32, 0x0b, 0x5e,
+	0x5b, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x6c, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x19, 0xba, 0xe9, 0xc0, 0x03, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x80, 0x66, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0xc0, 0x52, 0x03, 0x6c, 0x6e,
+	0x67, 0x12, 0x2b, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x19,
+	0xba, 0xe9, 0xc0, 0x03, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0x40,
+	0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x22, 0x99,
+	0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x08, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x13, 0x4e,
+	0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36,
+	0x0a, 0x09, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x19, 0xba, 0xe9, 0xc0, 0x03, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x92, 0xd3, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x72, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x12, 0x21, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xba, 0xe9, 0xc0, 0x03, 0x06, 0x1a, 0x04, 0x18, 0x64,
+	0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x7a, 0x0a, 0x14, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x50, 0x0a, 0x0c, 0x50, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b,
+	0x6d, 0x22, 0x53, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xba, 0xe9, 0xc0, 0x03, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xc8, 0x01,
+	0x52, 0x01, 0x71, 0x12, 0x21, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0b, 0xba, 0xe9, 0xc0, 0x03, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x76, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x42,
+	0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x4a, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x18, 0xba, 0xe9, 0xc0,
+	0x03, 0x13, 0x72, 0x11, 0x10, 0x05, 0x18, 0x05, 0x32, 0x0b, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x2f, 0x0a, 0x05, 0x61,
+	0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x46, 0x0a, 0x10,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x07, 0x50, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12,
+	0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2e, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xba, 0xe9, 0xc0, 0x03, 0x13, 0x72, 0x11, 0x10, 0x05, 0x18, 0x05, 0x32, 0x0b, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x12, 0x23, 0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xba, 0xe9, 0xc0, 0x03, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x06,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x0c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x05, 0xb8,
+	0xe9, 0xc0, 0x03, 0x01, 0x22, 0x50, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x14, 0xba, 0xe9, 0xc0, 0x03,
+	0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x05, 0x8a, 0x01, 0x02, 0x08, 0x01,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x65, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x8d, 0x01,
+	0x0a, 0x12, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x4a, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x18, 0xba,
+	0xe9, 0xc0, 0x03, 0x13, 0x72, 0x11, 0x10, 0x05, 0x18, 0x05, 0x32, 0x0b, 0x5e, 0x5b, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x77, 0x0a,
+	0x13, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe9, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x6f,
+	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xc7, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x18, 0xba, 0xe9, 0xc0, 0x03, 0x13, 0x72, 0x11, 0x10, 0x05, 0x18, 0x05, 0x32, 0x0b,
+	0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6c, 0x75,
 	0x67, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0a, 0xba, 0xe9, 0xc0, 0x03, 0x05, 0x8a, 0x01, 0x02, 0x10,
-	0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd1, 0x03, 0x0a, 0x0d, 0x50, 0x6f, 0x72, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x63,
-	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6f,
-	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0xca, 0x03, 0x0a, 0x0c,
-	0x50, 0x6f, 0x72, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x72, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x12, 0x1c, 0x2e, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x42,
-	0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x17, 0x2e,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x09, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x2e,
+	0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xd1, 0x03, 0x0a,
+	0x0d, 0x50, 0x6f, 0x72, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x30, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x30,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x2a, 0x3a, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x12, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x50,
+	0x53, 0x45, 0x52, 0x54, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x2a, 0xc1, 0x01, 0x0a,
+	0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a,
+	0x12, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x41,
+	0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e,
+	0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x47, 0x45, 0x4f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x15, 0x0a,
+	0x11, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43,
+	0x53, 0x56, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x06,
+	0x2a, 0x40, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x45,
+	0x52, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x50, 0x59,
+	0x10, 0x01, 0x2a, 0x9d, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43,
+	0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x45, 0x58, 0x49,
+	0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
+	0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x5f,
+	0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
+	0x10, 0x03, 0x2a, 0x8e, 0x01, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4a,
+	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x32, 0x82, 0x0c, 0x0a, 0x0c, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x42, 0x75, 0x6c, 0x6b, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x58, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x3e, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x2e,
 	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72,
-	0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x36, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12,
-	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x4e,
+	0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x10, 0x41, 0x62, 0x6f,
+	0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x1d, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x72, 0x65, 0x79, 0x79, 0x73, 0x65, 0x72, 0x2f,
 	0x74, 0x72, 0x61, 0x6e, 0x73, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x62,
@@ -937,60 +4775,201 @@ func file_portentries_portentries_proto_rawDescGZIP() []byte {
 	return file_portentries_portentries_proto_rawDescData
 }
 
-var file_portentries_portentries_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_portentries_portentries_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_portentries_portentries_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_portentries_portentries_proto_goTypes = []interface{}{
-	(*CreatePortRequest)(nil),     // 0: ports.CreatePortRequest
-	(*EmptyResponse)(nil),         // 1: ports.EmptyResponse
-	(*UpsertPortBulkRequest)(nil), // 2: ports.UpsertPortBulkRequest
-	(*ListPortsRequest)(nil),      // 3: ports.ListPortsRequest
-	(*ListPortsResponse)(nil),     // 4: ports.ListPortsResponse
-	(*Port)(nil),                  // 5: ports.Port
-	(*Coordinates)(nil),           // 6: ports.Coordinates
-	(*PortResponse)(nil),          // 7: ports.PortResponse
-	(*PortRequest)(nil),           // 8: ports.PortRequest
-	(*UpdatePortRequest)(nil),     // 9: ports.UpdatePortRequest
-	(*PortUpdatable)(nil),         // 10: ports.PortUpdatable
-	(*wrappers.Int64Value)(nil),   // 11: google.protobuf.Int64Value
-	(*wrappers.StringValue)(nil),  // 12: google.protobuf.StringValue
+	(ImportMode)(0),                   // 0: ports.ImportMode
+	(ImportFormat)(0),                 // 1: ports.ImportFormat
+	(ImportLoader)(0),                 // 2: ports.ImportLoader
+	(ConflictPolicy)(0),               // 3: ports.ConflictPolicy
+	(ImportJobStatus)(0),              // 4: ports.ImportJobStatus
+	(PortResult_Status)(0),            // 5: ports.PortResult.Status
+	(*CreatePortRequest)(nil),         // 6: ports.CreatePortRequest
+	(*EmptyResponse)(nil),             // 7: ports.EmptyResponse
+	(*UpsertPortBulkRequest)(nil),     // 8: ports.UpsertPortBulkRequest
+	(*UpsertPortBulkResponse)(nil),    // 9: ports.UpsertPortBulkResponse
+	(*StreamUpsertPortsRequest)(nil),  // 10: ports.StreamUpsertPortsRequest
+	(*StreamUpsertPortsResponse)(nil), // 11: ports.StreamUpsertPortsResponse
+	(*PortResult)(nil),                // 12: ports.PortResult
+	(*FieldError)(nil),                // 13: ports.FieldError
+	(*FieldErrors)(nil),               // 14: ports.FieldErrors
+	(*Conflicts)(nil),                 // 15: ports.Conflicts
+	(*StartPortsImportRequest)(nil),   // 16: ports.StartPortsImportRequest
+	(*PortsImportRequest)(nil),        // 17: ports.PortsImportRequest
+	(*PortsImportResponse)(nil),       // 18: ports.PortsImportResponse
+	(*StagePortsImportRequest)(nil),   // 19: ports.StagePortsImportRequest
+	(*StagePortsImportResponse)(nil),  // 20: ports.StagePortsImportResponse
+	(*FinishPortsImportRequest)(nil),  // 21: ports.FinishPortsImportRequest
+	(*PortsImportSummary)(nil),        // 22: ports.PortsImportSummary
+	(*ImportJob)(nil),                 // 23: ports.ImportJob
+	(*RecordError)(nil),               // 24: ports.RecordError
+	(*CreateImportJobRequest)(nil),    // 25: ports.CreateImportJobRequest
+	(*UpdateImportJobRequest)(nil),    // 26: ports.UpdateImportJobRequest
+	(*ImportJobRequest)(nil),          // 27: ports.ImportJobRequest
+	(*ImportJobResponse)(nil),         // 28: ports.ImportJobResponse
+	(*ListImportJobsRequest)(nil),     // 29: ports.ListImportJobsRequest
+	(*ListImportJobsResponse)(nil),    // 30: ports.ListImportJobsResponse
+	(*ListPortsRequest)(nil),          // 31: ports.ListPortsRequest
+	(*ExportPortsRequest)(nil),        // 32: ports.ExportPortsRequest
+	(*ExportPortsResponse)(nil),       // 33: ports.ExportPortsResponse
+	(*ListPortsResponse)(nil),         // 34: ports.ListPortsResponse
+	(*Port)(nil),                      // 35: ports.Port
+	(*Coordinates)(nil),               // 36: ports.Coordinates
+	(*PortResponse)(nil),              // 37: ports.PortResponse
+	(*NearestPortsRequest)(nil),       // 38: ports.NearestPortsRequest
+	(*NearestPortsResponse)(nil),      // 39: ports.NearestPortsResponse
+	(*PortDistance)(nil),              // 40: ports.PortDistance
+	(*SearchPortsRequest)(nil),        // 41: ports.SearchPortsRequest
+	(*SearchPortsResponse)(nil),       // 42: ports.SearchPortsResponse
+	(*PortMatch)(nil),                 // 43: ports.PortMatch
+	(*PortRequest)(nil),               // 44: ports.PortRequest
+	(*PortKey)(nil),                   // 45: ports.PortKey
+	(*BatchGetPortsRequest)(nil),      // 46: ports.BatchGetPortsRequest
+	(*BatchGetPortsResponse)(nil),     // 47: ports.BatchGetPortsResponse
+	(*PortHistoryRequest)(nil),        // 48: ports.PortHistoryRequest
+	(*PortHistoryResponse)(nil),       // 49: ports.PortHistoryResponse
+	(*PortChange)(nil),                // 50: ports.PortChange
+	(*UpdatePortRequest)(nil),         // 51: ports.UpdatePortRequest
+	(*PortUpdatable)(nil),             // 52: ports.PortUpdatable
+	nil,                               // 53: ports.Conflicts.FieldPoliciesEntry
+	(*timestamp.Timestamp)(nil),       // 54: google.protobuf.Timestamp
+	(*wrappers.Int64Value)(nil),       // 55: google.protobuf.Int64Value
+	(*wrappers.StringValue)(nil),      // 56: google.protobuf.StringValue
+	(*field_mask.FieldMask)(nil),      // 57: google.protobuf.FieldMask
 }
 var file_portentries_portentries_proto_depIdxs = []int32{
-	5,  // 0: ports.CreatePortRequest.data:type_name -> ports.Port
-	5,  // 1: ports.UpsertPortBulkRequest.data:type_name -> ports.Port
-	5,  // 2: ports.ListPortsResponse.data:type_name -> ports.Port
-	11, // 3: ports.Port.id:type_name -> google.protobuf.Int64Value
-	6,  // 4: ports.Port.coordinates:type_name -> ports.Coordinates
-	5,  // 5: ports.PortResponse.data:type_name -> ports.Port
-	11, // 6: ports.PortRequest.id:type_name -> google.protobuf.Int64Value
-	12, // 7: ports.PortRequest.slug:type_name -> google.protobuf.StringValue
-	11, // 8: ports.UpdatePortRequest.id:type_name -> google.protobuf.Int64Value
-	12, // 9: ports.UpdatePortRequest.slug:type_name -> google.protobuf.StringValue
-	10, // 10: ports.UpdatePortRequest.data:type_name -> ports.PortUpdatable
-	12, // 11: ports.PortUpdatable.name:type_name -> google.protobuf.StringValue
-	12, // 12: ports.PortUpdatable.city:type_name -> google.protobuf.StringValue
-	12, // 13: ports.PortUpdatable.province:type_name -> google.protobuf.StringValue
-	12, // 14: ports.PortUpdatable.country:type_name -> google.protobuf.StringValue
-	6,  // 15: ports.PortUpdatable.coordinates:type_name -> ports.Coordinates
-	12, // 16: ports.PortUpdatable.timezone:type_name -> google.protobuf.StringValue
-	12, // 17: ports.PortUpdatable.code:type_name -> google.protobuf.StringValue
-	0,  // 18: ports.PortsService.CreateOrUpdatePort:input_type -> ports.CreatePortRequest
-	2,  // 19: ports.PortsService.CreateOrUpdatePortBulk:input_type -> ports.UpsertPortBulkRequest
-	3,  // 20: ports.PortsService.ListPorts:input_type -> ports.ListPortsRequest
-	8,  // 21: ports.PortsService.FetchPort:input_type -> ports.PortRequest
-	0,  // 22: ports.PortsService.CreatePort:input_type -> ports.CreatePortRequest
-	9,  // 23: ports.PortsService.UpdatePort:input_type -> ports.UpdatePortRequest
-	8,  // 24: ports.PortsService.DeletePort:input_type -> ports.PortRequest
-	1,  // 25: ports.PortsService.CreateOrUpdatePort:output_type -> ports.EmptyResponse
-	1,  // 26: ports.PortsService.CreateOrUpdatePortBulk:output_type -> ports.EmptyResponse
-	4,  // 27: ports.PortsService.ListPorts:output_type -> ports.ListPortsResponse
-	7,  // 28: ports.PortsService.FetchPort:output_type -> ports.PortResponse
-	7,  // 29: ports.PortsService.CreatePort:output_type -> ports.PortResponse
-	7,  // 30: ports.PortsService.UpdatePort:output_type -> ports.PortResponse
-	1,  // 31: ports.PortsService.DeletePort:output_type -> ports.EmptyResponse
-	25, // [25:32] is the sub-list for method output_type
-	18, // [18:25] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	35,  // 0: ports.CreatePortRequest.data:type_name -> ports.Port
+	15,  // 1: ports.CreatePortRequest.conflicts:type_name -> ports.Conflicts
+	35,  // 2: ports.UpsertPortBulkRequest.data:type_name -> ports.Port
+	15,  // 3: ports.UpsertPortBulkRequest.conflicts:type_name -> ports.Conflicts
+	12,  // 4: ports.UpsertPortBulkResponse.results:type_name -> ports.PortResult
+	0,   // 5: ports.StreamUpsertPortsRequest.mode:type_name -> ports.ImportMode
+	35,  // 6: ports.StreamUpsertPortsRequest.data:type_name -> ports.Port
+	2,   // 7: ports.StreamUpsertPortsRequest.loader:type_name -> ports.ImportLoader
+	15,  // 8: ports.StreamUpsertPortsRequest.conflicts:type_name -> ports.Conflicts
+	12,  // 9: ports.StreamUpsertPortsResponse.failed_ports:type_name -> ports.PortResult
+	12,  // 10: ports.StreamUpsertPortsResponse.warned_ports:type_name -> ports.PortResult
+	5,   // 11: ports.PortResult.status:type_name -> ports.PortResult.Status
+	13,  // 12: ports.PortResult.errors:type_name -> ports.FieldError
+	13,  // 13: ports.PortResult.warnings:type_name -> ports.FieldError
+	13,  // 14: ports.FieldErrors.errors:type_name -> ports.FieldError
+	3,   // 15: ports.Conflicts.policy:type_name -> ports.ConflictPolicy
+	53,  // 16: ports.Conflicts.field_policies:type_name -> ports.Conflicts.FieldPoliciesEntry
+	2,   // 17: ports.StartPortsImportRequest.loader:type_name -> ports.ImportLoader
+	35,  // 18: ports.StagePortsImportRequest.data:type_name -> ports.Port
+	12,  // 19: ports.StagePortsImportResponse.failed:type_name -> ports.PortResult
+	12,  // 20: ports.StagePortsImportResponse.warned:type_name -> ports.PortResult
+	0,   // 21: ports.FinishPortsImportRequest.mode:type_name -> ports.ImportMode
+	15,  // 22: ports.FinishPortsImportRequest.conflicts:type_name -> ports.Conflicts
+	4,   // 23: ports.ImportJob.status:type_name -> ports.ImportJobStatus
+	0,   // 24: ports.ImportJob.mode:type_name -> ports.ImportMode
+	22,  // 25: ports.ImportJob.summary:type_name -> ports.PortsImportSummary
+	54,  // 26: ports.ImportJob.created_at:type_name -> google.protobuf.Timestamp
+	54,  // 27: ports.ImportJob.updated_at:type_name -> google.protobuf.Timestamp
+	54,  // 28: ports.ImportJob.finished_at:type_name -> google.protobuf.Timestamp
+	24,  // 29: ports.ImportJob.record_errors:type_name -> ports.RecordError
+	1,   // 30: ports.ImportJob.format:type_name -> ports.ImportFormat
+	2,   // 31: ports.ImportJob.loader:type_name -> ports.ImportLoader
+	15,  // 32: ports.ImportJob.conflicts:type_name -> ports.Conflicts
+	24,  // 33: ports.ImportJob.record_warnings:type_name -> ports.RecordError
+	13,  // 34: ports.RecordError.errors:type_name -> ports.FieldError
+	0,   // 35: ports.CreateImportJobRequest.mode:type_name -> ports.ImportMode
+	1,   // 36: ports.CreateImportJobRequest.format:type_name -> ports.ImportFormat
+	2,   // 37: ports.CreateImportJobRequest.loader:type_name -> ports.ImportLoader
+	15,  // 38: ports.CreateImportJobRequest.conflicts:type_name -> ports.Conflicts
+	4,   // 39: ports.UpdateImportJobRequest.status:type_name -> ports.ImportJobStatus
+	22,  // 40: ports.UpdateImportJobRequest.summary:type_name -> ports.PortsImportSummary
+	24,  // 41: ports.UpdateImportJobRequest.record_errors:type_name -> ports.RecordError
+	24,  // 42: ports.UpdateImportJobRequest.record_warnings:type_name -> ports.RecordError
+	23,  // 43: ports.ImportJobResponse.data:type_name -> ports.ImportJob
+	4,   // 44: ports.ListImportJobsRequest.status:type_name -> ports.ImportJobStatus
+	23,  // 45: ports.ListImportJobsResponse.data:type_name -> ports.ImportJob
+	54,  // 46: ports.ListPortsRequest.as_of:type_name -> google.protobuf.Timestamp
+	54,  // 47: ports.ExportPortsRequest.as_of:type_name -> google.protobuf.Timestamp
+	35,  // 48: ports.ExportPortsResponse.data:type_name -> ports.Port
+	35,  // 49: ports.ListPortsResponse.data:type_name -> ports.Port
+	55,  // 50: ports.Port.id:type_name -> google.protobuf.Int64Value
+	36,  // 51: ports.Port.coordinates:type_name -> ports.Coordinates
+	35,  // 52: ports.PortResponse.data:type_name -> ports.Port
+	13,  // 53: ports.PortResponse.warnings:type_name -> ports.FieldError
+	36,  // 54: ports.NearestPortsRequest.location:type_name -> ports.Coordinates
+	40,  // 55: ports.NearestPortsResponse.data:type_name -> ports.PortDistance
+	35,  // 56: ports.PortDistance.port:type_name -> ports.Port
+	43,  // 57: ports.SearchPortsResponse.data:type_name -> ports.PortMatch
+	35,  // 58: ports.PortMatch.port:type_name -> ports.Port
+	55,  // 59: ports.PortRequest.id:type_name -> google.protobuf.Int64Value
+	56,  // 60: ports.PortRequest.slug:type_name -> google.protobuf.StringValue
+	54,  // 61: ports.PortRequest.as_of:type_name -> google.protobuf.Timestamp
+	55,  // 62: ports.PortRequest.expected_version:type_name -> google.protobuf.Int64Value
+	45,  // 63: ports.BatchGetPortsRequest.keys:type_name -> ports.PortKey
+	35,  // 64: ports.BatchGetPortsResponse.data:type_name -> ports.Port
+	45,  // 65: ports.BatchGetPortsResponse.not_found:type_name -> ports.PortKey
+	55,  // 66: ports.PortHistoryRequest.id:type_name -> google.protobuf.Int64Value
+	56,  // 67: ports.PortHistoryRequest.slug:type_name -> google.protobuf.StringValue
+	50,  // 68: ports.PortHistoryResponse.data:type_name -> ports.PortChange
+	35,  // 69: ports.PortChange.old_value:type_name -> ports.Port
+	35,  // 70: ports.PortChange.new_value:type_name -> ports.Port
+	54,  // 71: ports.PortChange.changed_at:type_name -> google.protobuf.Timestamp
+	55,  // 72: ports.UpdatePortRequest.id:type_name -> google.protobuf.Int64Value
+	56,  // 73: ports.UpdatePortRequest.slug:type_name -> google.protobuf.StringValue
+	52,  // 74: ports.UpdatePortRequest.data:type_name -> ports.PortUpdatable
+	55,  // 75: ports.UpdatePortRequest.expected_version:type_name -> google.protobuf.Int64Value
+	57,  // 76: ports.UpdatePortRequest.update_mask:type_name -> google.protobuf.FieldMask
+	56,  // 77: ports.PortUpdatable.name:type_name -> google.protobuf.StringValue
+	56,  // 78: ports.PortUpdatable.city:type_name -> google.protobuf.StringValue
+	56,  // 79: ports.PortUpdatable.province:type_name -> google.protobuf.StringValue
+	56,  // 80: ports.PortUpdatable.country:type_name -> google.protobuf.StringValue
+	36,  // 81: ports.PortUpdatable.coordinates:type_name -> ports.Coordinates
+	56,  // 82: ports.PortUpdatable.timezone:type_name -> google.protobuf.StringValue
+	56,  // 83: ports.PortUpdatable.code:type_name -> google.protobuf.StringValue
+	3,   // 84: ports.Conflicts.FieldPoliciesEntry.value:type_name -> ports.ConflictPolicy
+	6,   // 85: ports.PortsService.CreateOrUpdatePort:input_type -> ports.CreatePortRequest
+	8,   // 86: ports.PortsService.CreateOrUpdatePortBulk:input_type -> ports.UpsertPortBulkRequest
+	10,  // 87: ports.PortsService.StreamUpsertPorts:input_type -> ports.StreamUpsertPortsRequest
+	31,  // 88: ports.PortsService.ListPorts:input_type -> ports.ListPortsRequest
+	32,  // 89: ports.PortsService.ExportPorts:input_type -> ports.ExportPortsRequest
+	44,  // 90: ports.PortsService.FetchPort:input_type -> ports.PortRequest
+	46,  // 91: ports.PortsService.BatchGetPorts:input_type -> ports.BatchGetPortsRequest
+	6,   // 92: ports.PortsService.CreatePort:input_type -> ports.CreatePortRequest
+	51,  // 93: ports.PortsService.UpdatePort:input_type -> ports.UpdatePortRequest
+	44,  // 94: ports.PortsService.DeletePort:input_type -> ports.PortRequest
+	38,  // 95: ports.PortsService.FindNearestPorts:input_type -> ports.NearestPortsRequest
+	41,  // 96: ports.PortsService.SearchPorts:input_type -> ports.SearchPortsRequest
+	48,  // 97: ports.PortsService.GetPortHistory:input_type -> ports.PortHistoryRequest
+	16,  // 98: ports.PortsService.StartPortsImport:input_type -> ports.StartPortsImportRequest
+	19,  // 99: ports.PortsService.StagePortsImport:input_type -> ports.StagePortsImportRequest
+	21,  // 100: ports.PortsService.FinishPortsImport:input_type -> ports.FinishPortsImportRequest
+	17,  // 101: ports.PortsService.AbortPortsImport:input_type -> ports.PortsImportRequest
+	25,  // 102: ports.PortsService.CreateImportJob:input_type -> ports.CreateImportJobRequest
+	26,  // 103: ports.PortsService.UpdateImportJob:input_type -> ports.UpdateImportJobRequest
+	27,  // 104: ports.PortsService.GetImportJob:input_type -> ports.ImportJobRequest
+	29,  // 105: ports.PortsService.ListImportJobs:input_type -> ports.ListImportJobsRequest
+	7,   // 106: ports.PortsService.CreateOrUpdatePort:output_type -> ports.EmptyResponse
+	9,   // 107: ports.PortsService.CreateOrUpdatePortBulk:output_type -> ports.UpsertPortBulkResponse
+	11,  // 108: ports.PortsService.StreamUpsertPorts:output_type -> ports.StreamUpsertPortsResponse
+	34,  // 109: ports.PortsService.ListPorts:output_type -> ports.ListPortsResponse
+	33,  // 110: ports.PortsService.ExportPorts:output_type -> ports.ExportPortsResponse
+	37,  // 111: ports.PortsService.FetchPort:output_type -> ports.PortResponse
+	47,  // 112: ports.PortsService.BatchGetPorts:output_type -> ports.BatchGetPortsResponse
+	37,  // 113: ports.PortsService.CreatePort:output_type -> ports.PortResponse
+	37,  // 114: ports.PortsService.UpdatePort:output_type -> ports.PortResponse
+	7,   // 115: ports.PortsService.DeletePort:output_type -> ports.EmptyResponse
+	39,  // 116: ports.PortsService.FindNearestPorts:output_type -> ports.NearestPortsResponse
+	42,  // 117: ports.PortsService.SearchPorts:output_type -> ports.SearchPortsResponse
+	49,  // 118: ports.PortsService.GetPortHistory:output_type -> ports.PortHistoryResponse
+	18,  // 119: ports.PortsService.StartPortsImport:output_type -> ports.PortsImportResponse
+	20,  // 120: ports.PortsService.StagePortsImport:output_type -> ports.StagePortsImportResponse
+	22,  // 121: ports.PortsService.FinishPortsImport:output_type -> ports.PortsImportSummary
+	7,   // 122: ports.PortsService.AbortPortsImport:output_type -> ports.EmptyResponse
+	28,  // 123: ports.PortsService.CreateImportJob:output_type -> ports.ImportJobResponse
+	28,  // 124: ports.PortsService.UpdateImportJob:output_type -> ports.ImportJobResponse
+	28,  // 125: ports.PortsService.GetImportJob:output_type -> ports.ImportJobResponse
+	30,  // 126: ports.PortsService.ListImportJobs:output_type -> ports.ListImportJobsResponse
+	106, // [106:127] is the sub-list for method output_type
+	85,  // [85:106] is the sub-list for method input_type
+	85,  // [85:85] is the sub-list for extension type_name
+	85,  // [85:85] is the sub-list for extension extendee
+	0,   // [0:85] is the sub-list for field type_name
 }
 
 func init() { file_portentries_portentries_proto_init() }
@@ -1011,8 +4990,296 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyResponse); i {
+		file_portentries_portentries_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertPortBulkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertPortBulkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUpsertPortsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUpsertPortsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldErrors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Conflicts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPortsImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortsImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortsImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagePortsImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagePortsImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishPortsImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortsImportSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateImportJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateImportJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPortsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1023,8 +5290,8 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertPortBulkRequest); i {
+		file_portentries_portentries_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPortsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1035,8 +5302,8 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPortsRequest); i {
+		file_portentries_portentries_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPortsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1047,7 +5314,7 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_portentries_portentries_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPortsResponse); i {
 			case 0:
 				return &v.state
@@ -1059,7 +5326,7 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_portentries_portentries_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Port); i {
 			case 0:
 				return &v.state
@@ -1071,7 +5338,7 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_portentries_portentries_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Coordinates); i {
 			case 0:
 				return &v.state
@@ -1083,7 +5350,7 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_portentries_portentries_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PortResponse); i {
 			case 0:
 				return &v.state
@@ -1095,7 +5362,79 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_portentries_portentries_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearestPortsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearestPortsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortDistance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPortsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPortsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PortRequest); i {
 			case 0:
 				return &v.state
@@ -1107,7 +5446,79 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_portentries_portentries_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetPortsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetPortsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_portentries_portentries_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdatePortRequest); i {
 			case 0:
 				return &v.state
@@ -1119,7 +5530,7 @@ func file_portentries_portentries_proto_init() {
 				return nil
 			}
 		}
-		file_portentries_portentries_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_portentries_portentries_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PortUpdatable); i {
 			case 0:
 				return &v.state
@@ -1132,18 +5543,24 @@ func file_portentries_portentries_proto_init() {
 			}
 		}
 	}
+	file_portentries_portentries_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*PortKey_Id)(nil),
+		(*PortKey_Slug)(nil),
+		(*PortKey_Unlock)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_portentries_portentries_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      6,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_portentries_portentries_proto_goTypes,
 		DependencyIndexes: file_portentries_portentries_proto_depIdxs,
+		EnumInfos:         file_portentries_portentries_proto_enumTypes,
 		MessageInfos:      file_portentries_portentries_proto_msgTypes,
 	}.Build()
 	File_portentries_portentries_proto = out.File
@@ -1165,79 +5582,274 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type PortsServiceClient interface {
 	CreateOrUpdatePort(ctx context.Context, in *CreatePortRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
-	CreateOrUpdatePortBulk(ctx context.Context, in *UpsertPortBulkRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	CreateOrUpdatePortBulk(ctx context.Context, in *UpsertPortBulkRequest, opts ...grpc.CallOption) (*UpsertPortBulkResponse, error)
+	// StreamUpsertPorts receives the ports in any number of messages and applies them at once
+	// when the client closes the stream, the service batches them on its own
+	StreamUpsertPorts(ctx context.Context, opts ...grpc.CallOption) (PortsService_StreamUpsertPortsClient, error)
 	ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error)
+	// ExportPorts streams every port matching the filters in batches
+	ExportPorts(ctx context.Context, in *ExportPortsRequest, opts ...grpc.CallOption) (PortsService_ExportPortsClient, error)
 	FetchPort(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*PortResponse, error)
+	// BatchGetPorts fetches the ports by their ids, slugs or unlocks at once
+	BatchGetPorts(ctx context.Context, in *BatchGetPortsRequest, opts ...grpc.CallOption) (*BatchGetPortsResponse, error)
 	CreatePort(ctx context.Context, in *CreatePortRequest, opts ...grpc.CallOption) (*PortResponse, error)
 	UpdatePort(ctx context.Context, in *UpdatePortRequest, opts ...grpc.CallOption) (*PortResponse, error)
 	DeletePort(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	FindNearestPorts(ctx context.Context, in *NearestPortsRequest, opts ...grpc.CallOption) (*NearestPortsResponse, error)
+	SearchPorts(ctx context.Context, in *SearchPortsRequest, opts ...grpc.CallOption) (*SearchPortsResponse, error)
+	GetPortHistory(ctx context.Context, in *PortHistoryRequest, opts ...grpc.CallOption) (*PortHistoryResponse, error)
+	// StartPortsImport opens an import, its ports are staged by StagePortsImport
+	// and applied all at once by FinishPortsImport
+	StartPortsImport(ctx context.Context, in *StartPortsImportRequest, opts ...grpc.CallOption) (*PortsImportResponse, error)
+	StagePortsImport(ctx context.Context, in *StagePortsImportRequest, opts ...grpc.CallOption) (*StagePortsImportResponse, error)
+	FinishPortsImport(ctx context.Context, in *FinishPortsImportRequest, opts ...grpc.CallOption) (*PortsImportSummary, error)
+	AbortPortsImport(ctx context.Context, in *PortsImportRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// import jobs track the progress of the imports run in the background
+	CreateImportJob(ctx context.Context, in *CreateImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error)
+	UpdateImportJob(ctx context.Context, in *UpdateImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error)
+	GetImportJob(ctx context.Context, in *ImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error)
+	ListImportJobs(ctx context.Context, in *ListImportJobsRequest, opts ...grpc.CallOption) (*ListImportJobsResponse, error)
+}
+
+type portsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPortsServiceClient(cc grpc.ClientConnInterface) PortsServiceClient {
+	return &portsServiceClient{cc}
+}
+
+func (c *portsServiceClient) CreateOrUpdatePort(ctx context.Context, in *CreatePortRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/CreateOrUpdatePort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) CreateOrUpdatePortBulk(ctx context.Context, in *UpsertPortBulkRequest, opts ...grpc.CallOption) (*UpsertPortBulkResponse, error) {
+	out := new(UpsertPortBulkResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/CreateOrUpdatePortBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) StreamUpsertPorts(ctx context.Context, opts ...grpc.CallOption) (PortsService_StreamUpsertPortsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PortsService_serviceDesc.Streams[0], "/ports.PortsService/StreamUpsertPorts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &portsServiceStreamUpsertPortsClient{stream}
+	return x, nil
+}
+
+type PortsService_StreamUpsertPortsClient interface {
+	Send(*StreamUpsertPortsRequest) error
+	CloseAndRecv() (*StreamUpsertPortsResponse, error)
+	grpc.ClientStream
+}
+
+type portsServiceStreamUpsertPortsClient struct {
+	grpc.ClientStream
+}
+
+func (x *portsServiceStreamUpsertPortsClient) Send(m *StreamUpsertPortsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *portsServiceStreamUpsertPortsClient) CloseAndRecv() (*StreamUpsertPortsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StreamUpsertPortsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *portsServiceClient) ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error) {
+	out := new(ListPortsResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/ListPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) ExportPorts(ctx context.Context, in *ExportPortsRequest, opts ...grpc.CallOption) (PortsService_ExportPortsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PortsService_serviceDesc.Streams[1], "/ports.PortsService/ExportPorts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &portsServiceExportPortsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PortsService_ExportPortsClient interface {
+	Recv() (*ExportPortsResponse, error)
+	grpc.ClientStream
+}
+
+type portsServiceExportPortsClient struct {
+	grpc.ClientStream
+}
+
+func (x *portsServiceExportPortsClient) Recv() (*ExportPortsResponse, error) {
+	m := new(ExportPortsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *portsServiceClient) FetchPort(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*PortResponse, error) {
+	out := new(PortResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/FetchPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) BatchGetPorts(ctx context.Context, in *BatchGetPortsRequest, opts ...grpc.CallOption) (*BatchGetPortsResponse, error) {
+	out := new(BatchGetPortsResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/BatchGetPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) CreatePort(ctx context.Context, in *CreatePortRequest, opts ...grpc.CallOption) (*PortResponse, error) {
+	out := new(PortResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/CreatePort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) UpdatePort(ctx context.Context, in *UpdatePortRequest, opts ...grpc.CallOption) (*PortResponse, error) {
+	out := new(PortResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/UpdatePort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) DeletePort(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/DeletePort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) FindNearestPorts(ctx context.Context, in *NearestPortsRequest, opts ...grpc.CallOption) (*NearestPortsResponse, error) {
+	out := new(NearestPortsResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/FindNearestPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portsServiceClient) SearchPorts(ctx context.Context, in *SearchPortsRequest, opts ...grpc.CallOption) (*SearchPortsResponse, error) {
+	out := new(SearchPortsResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/SearchPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type portsServiceClient struct {
-	cc grpc.ClientConnInterface
+func (c *portsServiceClient) GetPortHistory(ctx context.Context, in *PortHistoryRequest, opts ...grpc.CallOption) (*PortHistoryResponse, error) {
+	out := new(PortHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/GetPortHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewPortsServiceClient(cc grpc.ClientConnInterface) PortsServiceClient {
-	return &portsServiceClient{cc}
+func (c *portsServiceClient) StartPortsImport(ctx context.Context, in *StartPortsImportRequest, opts ...grpc.CallOption) (*PortsImportResponse, error) {
+	out := new(PortsImportResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/StartPortsImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *portsServiceClient) CreateOrUpdatePort(ctx context.Context, in *CreatePortRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/ports.PortsService/CreateOrUpdatePort", in, out, opts...)
+func (c *portsServiceClient) StagePortsImport(ctx context.Context, in *StagePortsImportRequest, opts ...grpc.CallOption) (*StagePortsImportResponse, error) {
+	out := new(StagePortsImportResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/StagePortsImport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *portsServiceClient) CreateOrUpdatePortBulk(ctx context.Context, in *UpsertPortBulkRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/ports.PortsService/CreateOrUpdatePortBulk", in, out, opts...)
+func (c *portsServiceClient) FinishPortsImport(ctx context.Context, in *FinishPortsImportRequest, opts ...grpc.CallOption) (*PortsImportSummary, error) {
+	out := new(PortsImportSummary)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/FinishPortsImport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *portsServiceClient) ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error) {
-	out := new(ListPortsResponse)
-	err := c.cc.Invoke(ctx, "/ports.PortsService/ListPorts", in, out, opts...)
+func (c *portsServiceClient) AbortPortsImport(ctx context.Context, in *PortsImportRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/AbortPortsImport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *portsServiceClient) FetchPort(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*PortResponse, error) {
-	out := new(PortResponse)
-	err := c.cc.Invoke(ctx, "/ports.PortsService/FetchPort", in, out, opts...)
+func (c *portsServiceClient) CreateImportJob(ctx context.Context, in *CreateImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error) {
+	out := new(ImportJobResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/CreateImportJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *portsServiceClient) CreatePort(ctx context.Context, in *CreatePortRequest, opts ...grpc.CallOption) (*PortResponse, error) {
-	out := new(PortResponse)
-	err := c.cc.Invoke(ctx, "/ports.PortsService/CreatePort", in, out, opts...)
+func (c *portsServiceClient) UpdateImportJob(ctx context.Context, in *UpdateImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error) {
+	out := new(ImportJobResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/UpdateImportJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *portsServiceClient) UpdatePort(ctx context.Context, in *UpdatePortRequest, opts ...grpc.CallOption) (*PortResponse, error) {
-	out := new(PortResponse)
-	err := c.cc.Invoke(ctx, "/ports.PortsService/UpdatePort", in, out, opts...)
+func (c *portsServiceClient) GetImportJob(ctx context.Context, in *ImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error) {
+	out := new(ImportJobResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/GetImportJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *portsServiceClient) DeletePort(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/ports.PortsService/DeletePort", in, out, opts...)
+func (c *portsServiceClient) ListImportJobs(ctx context.Context, in *ListImportJobsRequest, opts ...grpc.CallOption) (*ListImportJobsResponse, error) {
+	out := new(ListImportJobsResponse)
+	err := c.cc.Invoke(ctx, "/ports.PortsService/ListImportJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1247,12 +5859,33 @@ func (c *portsServiceClient) DeletePort(ctx context.Context, in *PortRequest, op
 // PortsServiceServer is the server API for PortsService service.
 type PortsServiceServer interface {
 	CreateOrUpdatePort(context.Context, *CreatePortRequest) (*EmptyResponse, error)
-	CreateOrUpdatePortBulk(context.Context, *UpsertPortBulkRequest) (*EmptyResponse, error)
+	CreateOrUpdatePortBulk(context.Context, *UpsertPortBulkRequest) (*UpsertPortBulkResponse, error)
+	// StreamUpsertPorts receives the ports in any number of messages and applies them at once
+	// when the client closes the stream, the service batches them on its own
+	StreamUpsertPorts(PortsService_StreamUpsertPortsServer) error
 	ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error)
+	// ExportPorts streams every port matching the filters in batches
+	ExportPorts(*ExportPortsRequest, PortsService_ExportPortsServer) error
 	FetchPort(context.Context, *PortRequest) (*PortResponse, error)
+	// BatchGetPorts fetches the ports by their ids, slugs or unlocks at once
+	BatchGetPorts(context.Context, *BatchGetPortsRequest) (*BatchGetPortsResponse, error)
 	CreatePort(context.Context, *CreatePortRequest) (*PortResponse, error)
 	UpdatePort(context.Context, *UpdatePortRequest) (*PortResponse, error)
 	DeletePort(context.Context, *PortRequest) (*EmptyResponse, error)
+	FindNearestPorts(context.Context, *NearestPortsRequest) (*NearestPortsResponse, error)
+	SearchPorts(context.Context, *SearchPortsRequest) (*SearchPortsResponse, error)
+	GetPortHistory(context.Context, *PortHistoryRequest) (*PortHistoryResponse, error)
+	// StartPortsImport opens an import, its ports are staged by StagePortsImport
+	// and applied all at once by FinishPortsImport
+	StartPortsImport(context.Context, *StartPortsImportRequest) (*PortsImportResponse, error)
+	StagePortsImport(context.Context, *StagePortsImportRequest) (*StagePortsImportResponse, error)
+	FinishPortsImport(context.Context, *FinishPortsImportRequest) (*PortsImportSummary, error)
+	AbortPortsImport(context.Context, *PortsImportRequest) (*EmptyResponse, error)
+	// import jobs track the progress of the imports run in the background
+	CreateImportJob(context.Context, *CreateImportJobRequest) (*ImportJobResponse, error)
+	UpdateImportJob(context.Context, *UpdateImportJobRequest) (*ImportJobResponse, error)
+	GetImportJob(context.Context, *ImportJobRequest) (*ImportJobResponse, error)
+	ListImportJobs(context.Context, *ListImportJobsRequest) (*ListImportJobsResponse, error)
 }
 
 // UnimplementedPortsServiceServer can be embedded to have forward compatible implementations.
@@ -1262,15 +5895,24 @@ type UnimplementedPortsServiceServer struct {
 func (*UnimplementedPortsServiceServer) CreateOrUpdatePort(context.Context, *CreatePortRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdatePort not implemented")
 }
-func (*UnimplementedPortsServiceServer) CreateOrUpdatePortBulk(context.Context, *UpsertPortBulkRequest) (*EmptyResponse, error) {
+func (*UnimplementedPortsServiceServer) CreateOrUpdatePortBulk(context.Context, *UpsertPortBulkRequest) (*UpsertPortBulkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdatePortBulk not implemented")
 }
+func (*UnimplementedPortsServiceServer) StreamUpsertPorts(PortsService_StreamUpsertPortsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUpsertPorts not implemented")
+}
 func (*UnimplementedPortsServiceServer) ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPorts not implemented")
 }
+func (*UnimplementedPortsServiceServer) ExportPorts(*ExportPortsRequest, PortsService_ExportPortsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPorts not implemented")
+}
 func (*UnimplementedPortsServiceServer) FetchPort(context.Context, *PortRequest) (*PortResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchPort not implemented")
 }
+func (*UnimplementedPortsServiceServer) BatchGetPorts(context.Context, *BatchGetPortsRequest) (*BatchGetPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPorts not implemented")
+}
 func (*UnimplementedPortsServiceServer) CreatePort(context.Context, *CreatePortRequest) (*PortResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePort not implemented")
 }
@@ -1280,6 +5922,39 @@ func (*UnimplementedPortsServiceServer) UpdatePort(context.Context, *UpdatePortR
 func (*UnimplementedPortsServiceServer) DeletePort(context.Context, *PortRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePort not implemented")
 }
+func (*UnimplementedPortsServiceServer) FindNearestPorts(context.Context, *NearestPortsRequest) (*NearestPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearestPorts not implemented")
+}
+func (*UnimplementedPortsServiceServer) SearchPorts(context.Context, *SearchPortsRequest) (*SearchPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPorts not implemented")
+}
+func (*UnimplementedPortsServiceServer) GetPortHistory(context.Context, *PortHistoryRequest) (*PortHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortHistory not implemented")
+}
+func (*UnimplementedPortsServiceServer) StartPortsImport(context.Context, *StartPortsImportRequest) (*PortsImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPortsImport not implemented")
+}
+func (*UnimplementedPortsServiceServer) StagePortsImport(context.Context, *StagePortsImportRequest) (*StagePortsImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StagePortsImport not implemented")
+}
+func (*UnimplementedPortsServiceServer) FinishPortsImport(context.Context, *FinishPortsImportRequest) (*PortsImportSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishPortsImport not implemented")
+}
+func (*UnimplementedPortsServiceServer) AbortPortsImport(context.Context, *PortsImportRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortPortsImport not implemented")
+}
+func (*UnimplementedPortsServiceServer) CreateImportJob(context.Context, *CreateImportJobRequest) (*ImportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateImportJob not implemented")
+}
+func (*UnimplementedPortsServiceServer) UpdateImportJob(context.Context, *UpdateImportJobRequest) (*ImportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateImportJob not implemented")
+}
+func (*UnimplementedPortsServiceServer) GetImportJob(context.Context, *ImportJobRequest) (*ImportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (*UnimplementedPortsServiceServer) ListImportJobs(context.Context, *ListImportJobsRequest) (*ListImportJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportJobs not implemented")
+}
 
 func RegisterPortsServiceServer(s *grpc.Server, srv PortsServiceServer) {
 	s.RegisterService(&_PortsService_serviceDesc, srv)
@@ -1321,6 +5996,32 @@ func _PortsService_CreateOrUpdatePortBulk_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortsService_StreamUpsertPorts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PortsServiceServer).StreamUpsertPorts(&portsServiceStreamUpsertPortsServer{stream})
+}
+
+type PortsService_StreamUpsertPortsServer interface {
+	SendAndClose(*StreamUpsertPortsResponse) error
+	Recv() (*StreamUpsertPortsRequest, error)
+	grpc.ServerStream
+}
+
+type portsServiceStreamUpsertPortsServer struct {
+	grpc.ServerStream
+}
+
+func (x *portsServiceStreamUpsertPortsServer) SendAndClose(m *StreamUpsertPortsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *portsServiceStreamUpsertPortsServer) Recv() (*StreamUpsertPortsRequest, error) {
+	m := new(StreamUpsertPortsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _PortsService_ListPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPortsRequest)
 	if err := dec(in); err != nil {
@@ -1339,6 +6040,27 @@ func _PortsService_ListPorts_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortsService_ExportPorts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPortsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PortsServiceServer).ExportPorts(m, &portsServiceExportPortsServer{stream})
+}
+
+type PortsService_ExportPortsServer interface {
+	Send(*ExportPortsResponse) error
+	grpc.ServerStream
+}
+
+type portsServiceExportPortsServer struct {
+	grpc.ServerStream
+}
+
+func (x *portsServiceExportPortsServer) Send(m *ExportPortsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PortsService_FetchPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PortRequest)
 	if err := dec(in); err != nil {
@@ -1357,6 +6079,24 @@ func _PortsService_FetchPort_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortsService_BatchGetPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).BatchGetPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/BatchGetPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).BatchGetPorts(ctx, req.(*BatchGetPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PortsService_CreatePort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePortRequest)
 	if err := dec(in); err != nil {
@@ -1411,6 +6151,204 @@ func _PortsService_DeletePort_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortsService_FindNearestPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearestPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).FindNearestPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/FindNearestPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).FindNearestPorts(ctx, req.(*NearestPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_SearchPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).SearchPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/SearchPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).SearchPorts(ctx, req.(*SearchPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_GetPortHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).GetPortHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/GetPortHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).GetPortHistory(ctx, req.(*PortHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_StartPortsImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPortsImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).StartPortsImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/StartPortsImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).StartPortsImport(ctx, req.(*StartPortsImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_StagePortsImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StagePortsImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).StagePortsImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/StagePortsImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).StagePortsImport(ctx, req.(*StagePortsImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_FinishPortsImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishPortsImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).FinishPortsImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/FinishPortsImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).FinishPortsImport(ctx, req.(*FinishPortsImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_AbortPortsImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortsImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).AbortPortsImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/AbortPortsImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).AbortPortsImport(ctx, req.(*PortsImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_CreateImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).CreateImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/CreateImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).CreateImportJob(ctx, req.(*CreateImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_UpdateImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).UpdateImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/UpdateImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).UpdateImportJob(ctx, req.(*UpdateImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/GetImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).GetImportJob(ctx, req.(*ImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortsService_ListImportJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortsServiceServer).ListImportJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ports.PortsService/ListImportJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortsServiceServer).ListImportJobs(ctx, req.(*ListImportJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PortsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ports.PortsService",
 	HandlerType: (*PortsServiceServer)(nil),
@@ -1431,6 +6369,10 @@ var _PortsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FetchPort",
 			Handler:    _PortsService_FetchPort_Handler,
 		},
+		{
+			MethodName: "BatchGetPorts",
+			Handler:    _PortsService_BatchGetPorts_Handler,
+		},
 		{
 			MethodName: "CreatePort",
 			Handler:    _PortsService_CreatePort_Handler,
@@ -1443,7 +6385,62 @@ var _PortsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePort",
 			Handler:    _PortsService_DeletePort_Handler,
 		},
+		{
+			MethodName: "FindNearestPorts",
+			Handler:    _PortsService_FindNearestPorts_Handler,
+		},
+		{
+			MethodName: "SearchPorts",
+			Handler:    _PortsService_SearchPorts_Handler,
+		},
+		{
+			MethodName: "GetPortHistory",
+			Handler:    _PortsService_GetPortHistory_Handler,
+		},
+		{
+			MethodName: "StartPortsImport",
+			Handler:    _PortsService_StartPortsImport_Handler,
+		},
+		{
+			MethodName: "StagePortsImport",
+			Handler:    _PortsService_StagePortsImport_Handler,
+		},
+		{
+			MethodName: "FinishPortsImport",
+			Handler:    _PortsService_FinishPortsImport_Handler,
+		},
+		{
+			MethodName: "AbortPortsImport",
+			Handler:    _PortsService_AbortPortsImport_Handler,
+		},
+		{
+			MethodName: "CreateImportJob",
+			Handler:    _PortsService_CreateImportJob_Handler,
+		},
+		{
+			MethodName: "UpdateImportJob",
+			Handler:    _PortsService_UpdateImportJob_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _PortsService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "ListImportJobs",
+			Handler:    _PortsService_ListImportJobs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamUpsertPorts",
+			Handler:       _PortsService_StreamUpsertPorts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportPorts",
+			Handler:       _PortsService_ExportPorts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "portentries/portentries.proto",
 }
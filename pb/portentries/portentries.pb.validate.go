@@ -11,11 +11,12 @@ import (
 	"net/mail"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 	"unicode/utf8"
 
-	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // ensure the imports are used
@@ -30,25 +31,63 @@ var (
 	_ = time.Duration(0)
 	_ = (*url.URL)(nil)
 	_ = (*mail.Address)(nil)
-	_ = ptypes.DynamicAny{}
+	_ = anypb.Any{}
+	_ = sort.Sort
 )
 
 // Validate checks the field values on CreatePortRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
 func (m *CreatePortRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePortRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePortRequestMultiError, or nil if none found.
+func (m *CreatePortRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePortRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if m.GetData() == nil {
-		return CreatePortRequestValidationError{
+		err := CreatePortRequestValidationError{
 			field:  "Data",
 			reason: "value is required",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePortRequestValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePortRequestValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return CreatePortRequestValidationError{
 				field:  "Data",
@@ -58,9 +97,59 @@ func (m *CreatePortRequest) Validate() error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetConflicts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePortRequestValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePortRequestValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConflicts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePortRequestValidationError{
+				field:  "Conflicts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePortRequestMultiError(errors)
+	}
+
 	return nil
 }
 
+// CreatePortRequestMultiError is an error wrapping multiple validation errors
+// returned by CreatePortRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePortRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePortRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePortRequestMultiError) AllErrors() []error { return m }
+
 // CreatePortRequestValidationError is the validation error returned by
 // CreatePortRequest.Validate if the designated constraints aren't met.
 type CreatePortRequestValidationError struct {
@@ -118,20 +207,55 @@ var _ interface {
 } = CreatePortRequestValidationError{}
 
 // Validate checks the field values on EmptyResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
 func (m *EmptyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmptyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EmptyResponseMultiError, or
+// nil if none found.
+func (m *EmptyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmptyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	// no validation rules for StatusCode
 
 	// no validation rules for Message
 
+	if len(errors) > 0 {
+		return EmptyResponseMultiError(errors)
+	}
+
 	return nil
 }
 
+// EmptyResponseMultiError is an error wrapping multiple validation errors
+// returned by EmptyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EmptyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmptyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmptyResponseMultiError) AllErrors() []error { return m }
+
 // EmptyResponseValidationError is the validation error returned by
 // EmptyResponse.Validate if the designated constraints aren't met.
 type EmptyResponseValidationError struct {
@@ -188,37 +312,99 @@ var _ interface {
 
 // Validate checks the field values on UpsertPortBulkRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *UpsertPortBulkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertPortBulkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertPortBulkRequestMultiError, or nil if none found.
+func (m *UpsertPortBulkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertPortBulkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	if len(m.GetData()) < 1 {
-		return UpsertPortBulkRequestValidationError{
+		err := UpsertPortBulkRequestValidationError{
 			field:  "Data",
 			reason: "value must contain at least 1 item(s)",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	for idx, item := range m.GetData() {
 		_, _ = idx, item
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		// skipping validation for data
+
+	}
+
+	// no validation rules for ContinueOnError
+
+	if all {
+		switch v := interface{}(m.GetConflicts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertPortBulkRequestValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return UpsertPortBulkRequestValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				errors = append(errors, UpsertPortBulkRequestValidationError{
+					field:  "Conflicts",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConflicts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertPortBulkRequestValidationError{
+				field:  "Conflicts",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return UpsertPortBulkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
+// UpsertPortBulkRequestMultiError is an error wrapping multiple validation
+// errors returned by UpsertPortBulkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertPortBulkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertPortBulkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertPortBulkRequestMultiError) AllErrors() []error { return m }
+
 // UpsertPortBulkRequestValidationError is the validation error returned by
 // UpsertPortBulkRequest.Validate if the designated constraints aren't met.
 type UpsertPortBulkRequestValidationError struct {
@@ -275,20 +461,101 @@ var _ interface {
 	ErrorName() string
 } = UpsertPortBulkRequestValidationError{}
 
-// Validate checks the field values on ListPortsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListPortsRequest) Validate() error {
+// Validate checks the field values on UpsertPortBulkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertPortBulkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertPortBulkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertPortBulkResponseMultiError, or nil if none found.
+func (m *UpsertPortBulkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertPortBulkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertPortBulkResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertPortBulkResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertPortBulkResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Created
+
+	// no validation rules for Updated
+
+	// no validation rules for Unchanged
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return UpsertPortBulkResponseMultiError(errors)
+	}
+
 	return nil
 }
 
-// ListPortsRequestValidationError is the validation error returned by
-// ListPortsRequest.Validate if the designated constraints aren't met.
-type ListPortsRequestValidationError struct {
+// UpsertPortBulkResponseMultiError is an error wrapping multiple validation
+// errors returned by UpsertPortBulkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertPortBulkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertPortBulkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertPortBulkResponseMultiError) AllErrors() []error { return m }
+
+// UpsertPortBulkResponseValidationError is the validation error returned by
+// UpsertPortBulkResponse.Validate if the designated constraints aren't met.
+type UpsertPortBulkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -296,22 +563,24 @@ type ListPortsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPortsRequestValidationError) Field() string { return e.field }
+func (e UpsertPortBulkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPortsRequestValidationError) Reason() string { return e.reason }
+func (e UpsertPortBulkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPortsRequestValidationError) Cause() error { return e.cause }
+func (e UpsertPortBulkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPortsRequestValidationError) Key() bool { return e.key }
+func (e UpsertPortBulkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPortsRequestValidationError) ErrorName() string { return "ListPortsRequestValidationError" }
+func (e UpsertPortBulkResponseValidationError) ErrorName() string {
+	return "UpsertPortBulkResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListPortsRequestValidationError) Error() string {
+func (e UpsertPortBulkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -323,14 +592,14 @@ func (e ListPortsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPortsRequest.%s: %s%s",
+		"invalid %sUpsertPortBulkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPortsRequestValidationError{}
+var _ error = UpsertPortBulkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -338,41 +607,117 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPortsRequestValidationError{}
+} = UpsertPortBulkResponseValidationError{}
 
-// Validate checks the field values on ListPortsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListPortsResponse) Validate() error {
+// Validate checks the field values on StreamUpsertPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamUpsertPortsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamUpsertPortsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamUpsertPortsRequestMultiError, or nil if none found.
+func (m *StreamUpsertPortsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamUpsertPortsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for StatusCode
+	var errors []error
 
-	// no validation rules for Message
+	if _, ok := ImportMode_name[int32(m.GetMode())]; !ok {
+		err := StreamUpsertPortsRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
 
 	for idx, item := range m.GetData() {
 		_, _ = idx, item
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		// skipping validation for data
+
+	}
+
+	if _, ok := ImportLoader_name[int32(m.GetLoader())]; !ok {
+		err := StreamUpsertPortsRequestValidationError{
+			field:  "Loader",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConflicts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamUpsertPortsRequestValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListPortsResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				errors = append(errors, StreamUpsertPortsRequestValidationError{
+					field:  "Conflicts",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConflicts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamUpsertPortsRequestValidationError{
+				field:  "Conflicts",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return StreamUpsertPortsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPortsResponseValidationError is the validation error returned by
-// ListPortsResponse.Validate if the designated constraints aren't met.
-type ListPortsResponseValidationError struct {
+// StreamUpsertPortsRequestMultiError is an error wrapping multiple validation
+// errors returned by StreamUpsertPortsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StreamUpsertPortsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamUpsertPortsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamUpsertPortsRequestMultiError) AllErrors() []error { return m }
+
+// StreamUpsertPortsRequestValidationError is the validation error returned by
+// StreamUpsertPortsRequest.Validate if the designated constraints aren't met.
+type StreamUpsertPortsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -380,24 +725,24 @@ type ListPortsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPortsResponseValidationError) Field() string { return e.field }
+func (e StreamUpsertPortsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPortsResponseValidationError) Reason() string { return e.reason }
+func (e StreamUpsertPortsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPortsResponseValidationError) Cause() error { return e.cause }
+func (e StreamUpsertPortsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPortsResponseValidationError) Key() bool { return e.key }
+func (e StreamUpsertPortsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPortsResponseValidationError) ErrorName() string {
-	return "ListPortsResponseValidationError"
+func (e StreamUpsertPortsRequestValidationError) ErrorName() string {
+	return "StreamUpsertPortsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPortsResponseValidationError) Error() string {
+func (e StreamUpsertPortsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -409,14 +754,14 @@ func (e ListPortsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPortsResponse.%s: %s%s",
+		"invalid %sStreamUpsertPortsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPortsResponseValidationError{}
+var _ error = StreamUpsertPortsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -424,68 +769,147 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPortsResponseValidationError{}
+} = StreamUpsertPortsRequestValidationError{}
 
-// Validate checks the field values on Port with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *Port) Validate() error {
+// Validate checks the field values on StreamUpsertPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamUpsertPortsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamUpsertPortsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamUpsertPortsResponseMultiError, or nil if none found.
+func (m *StreamUpsertPortsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamUpsertPortsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetSlug()) != 5 {
-		return PortValidationError{
-			field:  "Slug",
-			reason: "value length must be 5 runes",
-		}
+	var errors []error
 
-	}
+	// no validation rules for StatusCode
 
-	if !_Port_Slug_Pattern.MatchString(m.GetSlug()) {
-		return PortValidationError{
-			field:  "Slug",
-			reason: "value does not match regex pattern \"^[A-Z0-9]+$\"",
-		}
-	}
+	// no validation rules for Message
 
-	if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PortValidationError{
-				field:  "Id",
-				reason: "embedded message failed validation",
-				cause:  err,
+	// no validation rules for Created
+
+	// no validation rules for Updated
+
+	// no validation rules for Unchanged
+
+	// no validation rules for Removed
+
+	// no validation rules for DryRun
+
+	// no validation rules for Received
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetFailedPorts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamUpsertPortsResponseValidationError{
+						field:  fmt.Sprintf("FailedPorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamUpsertPortsResponseValidationError{
+						field:  fmt.Sprintf("FailedPorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamUpsertPortsResponseValidationError{
+					field:  fmt.Sprintf("FailedPorts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for Name
+	}
 
-	// no validation rules for City
+	// no validation rules for Aborted
 
-	// no validation rules for Province
+	// no validation rules for Warned
 
-	// no validation rules for Country
+	for idx, item := range m.GetWarnedPorts() {
+		_, _ = idx, item
 
-	if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PortValidationError{
-				field:  "Coordinates",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamUpsertPortsResponseValidationError{
+						field:  fmt.Sprintf("WarnedPorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamUpsertPortsResponseValidationError{
+						field:  fmt.Sprintf("WarnedPorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamUpsertPortsResponseValidationError{
+					field:  fmt.Sprintf("WarnedPorts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for Timezone
+	}
 
-	// no validation rules for Code
+	if len(errors) > 0 {
+		return StreamUpsertPortsResponseMultiError(errors)
+	}
 
 	return nil
 }
 
-// PortValidationError is the validation error returned by Port.Validate if the
+// StreamUpsertPortsResponseMultiError is an error wrapping multiple validation
+// errors returned by StreamUpsertPortsResponse.ValidateAll() if the
 // designated constraints aren't met.
-type PortValidationError struct {
+type StreamUpsertPortsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamUpsertPortsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamUpsertPortsResponseMultiError) AllErrors() []error { return m }
+
+// StreamUpsertPortsResponseValidationError is the validation error returned by
+// StreamUpsertPortsResponse.Validate if the designated constraints aren't met.
+type StreamUpsertPortsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -493,22 +917,3735 @@ type PortValidationError struct {
 }
 
 // Field function returns field value.
-func (e PortValidationError) Field() string { return e.field }
+func (e StreamUpsertPortsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PortValidationError) Reason() string { return e.reason }
+func (e StreamUpsertPortsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PortValidationError) Cause() error { return e.cause }
+func (e StreamUpsertPortsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PortValidationError) Key() bool { return e.key }
+func (e StreamUpsertPortsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PortValidationError) ErrorName() string { return "PortValidationError" }
+func (e StreamUpsertPortsResponseValidationError) ErrorName() string {
+	return "StreamUpsertPortsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PortValidationError) Error() string {
+func (e StreamUpsertPortsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamUpsertPortsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamUpsertPortsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamUpsertPortsResponseValidationError{}
+
+// Validate checks the field values on PortResult with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PortResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PortResultMultiError, or
+// nil if none found.
+func (m *PortResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for Slug
+
+	// no validation rules for Status
+
+	// no validation rules for Id
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PortResultValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PortResultValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PortResultValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PortResultValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PortResultValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PortResultValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PortResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortResultMultiError is an error wrapping multiple validation errors
+// returned by PortResult.ValidateAll() if the designated constraints aren't met.
+type PortResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortResultMultiError) AllErrors() []error { return m }
+
+// PortResultValidationError is the validation error returned by
+// PortResult.Validate if the designated constraints aren't met.
+type PortResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortResultValidationError) ErrorName() string { return "PortResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PortResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortResultValidationError{}
+
+// Validate checks the field values on FieldError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldErrorMultiError, or
+// nil if none found.
+func (m *FieldError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Reason
+
+	// no validation rules for Rule
+
+	if len(errors) > 0 {
+		return FieldErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldErrorMultiError is an error wrapping multiple validation errors
+// returned by FieldError.ValidateAll() if the designated constraints aren't met.
+type FieldErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldErrorMultiError) AllErrors() []error { return m }
+
+// FieldErrorValidationError is the validation error returned by
+// FieldError.Validate if the designated constraints aren't met.
+type FieldErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldErrorValidationError) ErrorName() string { return "FieldErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldErrorValidationError{}
+
+// Validate checks the field values on FieldErrors with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldErrors) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldErrors with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldErrorsMultiError, or
+// nil if none found.
+func (m *FieldErrors) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldErrors) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FieldErrorsValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FieldErrorsValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FieldErrorsValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FieldErrorsMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldErrorsMultiError is an error wrapping multiple validation errors
+// returned by FieldErrors.ValidateAll() if the designated constraints aren't met.
+type FieldErrorsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldErrorsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldErrorsMultiError) AllErrors() []error { return m }
+
+// FieldErrorsValidationError is the validation error returned by
+// FieldErrors.Validate if the designated constraints aren't met.
+type FieldErrorsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldErrorsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldErrorsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldErrorsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldErrorsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldErrorsValidationError) ErrorName() string { return "FieldErrorsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldErrorsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldErrors.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldErrorsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldErrorsValidationError{}
+
+// Validate checks the field values on Conflicts with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Conflicts) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Conflicts with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConflictsMultiError, or nil
+// if none found.
+func (m *Conflicts) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Conflicts) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ConflictPolicy_name[int32(m.GetPolicy())]; !ok {
+		err := ConflictsValidationError{
+			field:  "Policy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetFieldPolicies()))
+		i := 0
+		for key := range m.GetFieldPolicies() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetFieldPolicies()[key]
+			_ = val
+
+			// no validation rules for FieldPolicies[key]
+
+			if _, ok := ConflictPolicy_name[int32(val)]; !ok {
+				err := ConflictsValidationError{
+					field:  fmt.Sprintf("FieldPolicies[%v]", key),
+					reason: "value must be one of the defined enum values",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConflictsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConflictsMultiError is an error wrapping multiple validation errors returned
+// by Conflicts.ValidateAll() if the designated constraints aren't met.
+type ConflictsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConflictsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConflictsMultiError) AllErrors() []error { return m }
+
+// ConflictsValidationError is the validation error returned by
+// Conflicts.Validate if the designated constraints aren't met.
+type ConflictsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConflictsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConflictsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConflictsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConflictsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConflictsValidationError) ErrorName() string { return "ConflictsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConflictsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConflicts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConflictsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConflictsValidationError{}
+
+// Validate checks the field values on StartPortsImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPortsImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPortsImportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPortsImportRequestMultiError, or nil if none found.
+func (m *StartPortsImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPortsImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ImportLoader_name[int32(m.GetLoader())]; !ok {
+		err := StartPortsImportRequestValidationError{
+			field:  "Loader",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartPortsImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPortsImportRequestMultiError is an error wrapping multiple validation
+// errors returned by StartPortsImportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartPortsImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPortsImportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPortsImportRequestMultiError) AllErrors() []error { return m }
+
+// StartPortsImportRequestValidationError is the validation error returned by
+// StartPortsImportRequest.Validate if the designated constraints aren't met.
+type StartPortsImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPortsImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPortsImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPortsImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPortsImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPortsImportRequestValidationError) ErrorName() string {
+	return "StartPortsImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPortsImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPortsImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPortsImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPortsImportRequestValidationError{}
+
+// Validate checks the field values on PortsImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PortsImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortsImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PortsImportRequestMultiError, or nil if none found.
+func (m *PortsImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortsImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImportId()) < 1 {
+		err := PortsImportRequestValidationError{
+			field:  "ImportId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PortsImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortsImportRequestMultiError is an error wrapping multiple validation errors
+// returned by PortsImportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PortsImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortsImportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortsImportRequestMultiError) AllErrors() []error { return m }
+
+// PortsImportRequestValidationError is the validation error returned by
+// PortsImportRequest.Validate if the designated constraints aren't met.
+type PortsImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortsImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortsImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortsImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortsImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortsImportRequestValidationError) ErrorName() string {
+	return "PortsImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PortsImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortsImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortsImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortsImportRequestValidationError{}
+
+// Validate checks the field values on PortsImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PortsImportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortsImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PortsImportResponseMultiError, or nil if none found.
+func (m *PortsImportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortsImportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	// no validation rules for ImportId
+
+	if len(errors) > 0 {
+		return PortsImportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortsImportResponseMultiError is an error wrapping multiple validation
+// errors returned by PortsImportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PortsImportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortsImportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortsImportResponseMultiError) AllErrors() []error { return m }
+
+// PortsImportResponseValidationError is the validation error returned by
+// PortsImportResponse.Validate if the designated constraints aren't met.
+type PortsImportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortsImportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortsImportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortsImportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortsImportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortsImportResponseValidationError) ErrorName() string {
+	return "PortsImportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PortsImportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortsImportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortsImportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortsImportResponseValidationError{}
+
+// Validate checks the field values on StagePortsImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StagePortsImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StagePortsImportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StagePortsImportRequestMultiError, or nil if none found.
+func (m *StagePortsImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StagePortsImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImportId()) < 1 {
+		err := StagePortsImportRequestValidationError{
+			field:  "ImportId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetData()) < 1 {
+		err := StagePortsImportRequestValidationError{
+			field:  "Data",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		// skipping validation for data
+
+	}
+
+	if len(errors) > 0 {
+		return StagePortsImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StagePortsImportRequestMultiError is an error wrapping multiple validation
+// errors returned by StagePortsImportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StagePortsImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StagePortsImportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StagePortsImportRequestMultiError) AllErrors() []error { return m }
+
+// StagePortsImportRequestValidationError is the validation error returned by
+// StagePortsImportRequest.Validate if the designated constraints aren't met.
+type StagePortsImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StagePortsImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StagePortsImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StagePortsImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StagePortsImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StagePortsImportRequestValidationError) ErrorName() string {
+	return "StagePortsImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StagePortsImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStagePortsImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StagePortsImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StagePortsImportRequestValidationError{}
+
+// Validate checks the field values on StagePortsImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StagePortsImportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StagePortsImportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StagePortsImportResponseMultiError, or nil if none found.
+func (m *StagePortsImportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StagePortsImportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetFailed() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StagePortsImportResponseValidationError{
+						field:  fmt.Sprintf("Failed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StagePortsImportResponseValidationError{
+						field:  fmt.Sprintf("Failed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StagePortsImportResponseValidationError{
+					field:  fmt.Sprintf("Failed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetWarned() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StagePortsImportResponseValidationError{
+						field:  fmt.Sprintf("Warned[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StagePortsImportResponseValidationError{
+						field:  fmt.Sprintf("Warned[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StagePortsImportResponseValidationError{
+					field:  fmt.Sprintf("Warned[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StagePortsImportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StagePortsImportResponseMultiError is an error wrapping multiple validation
+// errors returned by StagePortsImportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StagePortsImportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StagePortsImportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StagePortsImportResponseMultiError) AllErrors() []error { return m }
+
+// StagePortsImportResponseValidationError is the validation error returned by
+// StagePortsImportResponse.Validate if the designated constraints aren't met.
+type StagePortsImportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StagePortsImportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StagePortsImportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StagePortsImportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StagePortsImportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StagePortsImportResponseValidationError) ErrorName() string {
+	return "StagePortsImportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StagePortsImportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStagePortsImportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StagePortsImportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StagePortsImportResponseValidationError{}
+
+// Validate checks the field values on FinishPortsImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishPortsImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPortsImportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishPortsImportRequestMultiError, or nil if none found.
+func (m *FinishPortsImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPortsImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImportId()) < 1 {
+		err := FinishPortsImportRequestValidationError{
+			field:  "ImportId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ImportMode_name[int32(m.GetMode())]; !ok {
+		err := FinishPortsImportRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if all {
+		switch v := interface{}(m.GetConflicts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishPortsImportRequestValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishPortsImportRequestValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConflicts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishPortsImportRequestValidationError{
+				field:  "Conflicts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FinishPortsImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPortsImportRequestMultiError is an error wrapping multiple validation
+// errors returned by FinishPortsImportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FinishPortsImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPortsImportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPortsImportRequestMultiError) AllErrors() []error { return m }
+
+// FinishPortsImportRequestValidationError is the validation error returned by
+// FinishPortsImportRequest.Validate if the designated constraints aren't met.
+type FinishPortsImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPortsImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPortsImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPortsImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPortsImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPortsImportRequestValidationError) ErrorName() string {
+	return "FinishPortsImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPortsImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPortsImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPortsImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPortsImportRequestValidationError{}
+
+// Validate checks the field values on PortsImportSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PortsImportSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortsImportSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PortsImportSummaryMultiError, or nil if none found.
+func (m *PortsImportSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortsImportSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	// no validation rules for Created
+
+	// no validation rules for Updated
+
+	// no validation rules for Unchanged
+
+	// no validation rules for Removed
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return PortsImportSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortsImportSummaryMultiError is an error wrapping multiple validation errors
+// returned by PortsImportSummary.ValidateAll() if the designated constraints
+// aren't met.
+type PortsImportSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortsImportSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortsImportSummaryMultiError) AllErrors() []error { return m }
+
+// PortsImportSummaryValidationError is the validation error returned by
+// PortsImportSummary.Validate if the designated constraints aren't met.
+type PortsImportSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortsImportSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortsImportSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortsImportSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortsImportSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortsImportSummaryValidationError) ErrorName() string {
+	return "PortsImportSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PortsImportSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortsImportSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortsImportSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortsImportSummaryValidationError{}
+
+// Validate checks the field values on ImportJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportJobMultiError, or nil
+// if none found.
+func (m *ImportJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Mode
+
+	// no validation rules for DryRun
+
+	// no validation rules for FileName
+
+	// no validation rules for Actor
+
+	// no validation rules for ImportId
+
+	// no validation rules for Processed
+
+	// no validation rules for Failed
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRecordErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("RecordErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("RecordErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportJobValidationError{
+					field:  fmt.Sprintf("RecordErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Format
+
+	// no validation rules for SlugProperty
+
+	// no validation rules for Loader
+
+	if all {
+		switch v := interface{}(m.GetConflicts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConflicts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "Conflicts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Warned
+
+	for idx, item := range m.GetRecordWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("RecordWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("RecordWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportJobValidationError{
+					field:  fmt.Sprintf("RecordWarnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobMultiError is an error wrapping multiple validation errors returned
+// by ImportJob.ValidateAll() if the designated constraints aren't met.
+type ImportJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobMultiError) AllErrors() []error { return m }
+
+// ImportJobValidationError is the validation error returned by
+// ImportJob.Validate if the designated constraints aren't met.
+type ImportJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobValidationError) ErrorName() string { return "ImportJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobValidationError{}
+
+// Validate checks the field values on RecordError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecordError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecordErrorMultiError, or
+// nil if none found.
+func (m *RecordError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Offset
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecordErrorValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecordErrorValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecordErrorValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for File
+
+	if len(errors) > 0 {
+		return RecordErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordErrorMultiError is an error wrapping multiple validation errors
+// returned by RecordError.ValidateAll() if the designated constraints aren't met.
+type RecordErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordErrorMultiError) AllErrors() []error { return m }
+
+// RecordErrorValidationError is the validation error returned by
+// RecordError.Validate if the designated constraints aren't met.
+type RecordErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordErrorValidationError) ErrorName() string { return "RecordErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecordErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordErrorValidationError{}
+
+// Validate checks the field values on CreateImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateImportJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateImportJobRequestMultiError, or nil if none found.
+func (m *CreateImportJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateImportJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ImportMode_name[int32(m.GetMode())]; !ok {
+		err := CreateImportJobRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if utf8.RuneCountInString(m.GetFileName()) > 255 {
+		err := CreateImportJobRequestValidationError{
+			field:  "FileName",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ImportFormat_name[int32(m.GetFormat())]; !ok {
+		err := CreateImportJobRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSlugProperty()) > 64 {
+		err := CreateImportJobRequestValidationError{
+			field:  "SlugProperty",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ImportLoader_name[int32(m.GetLoader())]; !ok {
+		err := CreateImportJobRequestValidationError{
+			field:  "Loader",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConflicts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateImportJobRequestValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateImportJobRequestValidationError{
+					field:  "Conflicts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConflicts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateImportJobRequestValidationError{
+				field:  "Conflicts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateImportJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateImportJobRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateImportJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateImportJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateImportJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateImportJobRequestMultiError) AllErrors() []error { return m }
+
+// CreateImportJobRequestValidationError is the validation error returned by
+// CreateImportJobRequest.Validate if the designated constraints aren't met.
+type CreateImportJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateImportJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateImportJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateImportJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateImportJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateImportJobRequestValidationError) ErrorName() string {
+	return "CreateImportJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateImportJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateImportJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateImportJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateImportJobRequestValidationError{}
+
+// Validate checks the field values on UpdateImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateImportJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateImportJobRequestMultiError, or nil if none found.
+func (m *UpdateImportJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateImportJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateImportJobRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ImportJobStatus_name[int32(m.GetStatus())]; !ok {
+		err := UpdateImportJobRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ImportId
+
+	if m.GetProcessed() < 0 {
+		err := UpdateImportJobRequestValidationError{
+			field:  "Processed",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFailed() < 0 {
+		err := UpdateImportJobRequestValidationError{
+			field:  "Failed",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateImportJobRequestValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateImportJobRequestValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateImportJobRequestValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRecordErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateImportJobRequestValidationError{
+						field:  fmt.Sprintf("RecordErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateImportJobRequestValidationError{
+						field:  fmt.Sprintf("RecordErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateImportJobRequestValidationError{
+					field:  fmt.Sprintf("RecordErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetWarned() < 0 {
+		err := UpdateImportJobRequestValidationError{
+			field:  "Warned",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRecordWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateImportJobRequestValidationError{
+						field:  fmt.Sprintf("RecordWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateImportJobRequestValidationError{
+						field:  fmt.Sprintf("RecordWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateImportJobRequestValidationError{
+					field:  fmt.Sprintf("RecordWarnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateImportJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateImportJobRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateImportJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateImportJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateImportJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateImportJobRequestMultiError) AllErrors() []error { return m }
+
+// UpdateImportJobRequestValidationError is the validation error returned by
+// UpdateImportJobRequest.Validate if the designated constraints aren't met.
+type UpdateImportJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateImportJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateImportJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateImportJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateImportJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateImportJobRequestValidationError) ErrorName() string {
+	return "UpdateImportJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateImportJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateImportJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateImportJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateImportJobRequestValidationError{}
+
+// Validate checks the field values on ImportJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportJobRequestMultiError, or nil if none found.
+func (m *ImportJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ImportJobRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobRequestMultiError) AllErrors() []error { return m }
+
+// ImportJobRequestValidationError is the validation error returned by
+// ImportJobRequest.Validate if the designated constraints aren't met.
+type ImportJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobRequestValidationError) ErrorName() string { return "ImportJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobRequestValidationError{}
+
+// Validate checks the field values on ImportJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportJobResponseMultiError, or nil if none found.
+func (m *ImportJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobResponseMultiError is an error wrapping multiple validation errors
+// returned by ImportJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobResponseMultiError) AllErrors() []error { return m }
+
+// ImportJobResponseValidationError is the validation error returned by
+// ImportJobResponse.Validate if the designated constraints aren't met.
+type ImportJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobResponseValidationError) ErrorName() string {
+	return "ImportJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobResponseValidationError{}
+
+// Validate checks the field values on ListImportJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListImportJobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListImportJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListImportJobsRequestMultiError, or nil if none found.
+func (m *ListImportJobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListImportJobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListImportJobsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	for idx, item := range m.GetStatus() {
+		_, _ = idx, item
+
+		if _, ok := ImportJobStatus_name[int32(item)]; !ok {
+			err := ListImportJobsRequestValidationError{
+				field:  fmt.Sprintf("Status[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListImportJobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListImportJobsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListImportJobsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListImportJobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListImportJobsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListImportJobsRequestMultiError) AllErrors() []error { return m }
+
+// ListImportJobsRequestValidationError is the validation error returned by
+// ListImportJobsRequest.Validate if the designated constraints aren't met.
+type ListImportJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListImportJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListImportJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListImportJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListImportJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListImportJobsRequestValidationError) ErrorName() string {
+	return "ListImportJobsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListImportJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListImportJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListImportJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListImportJobsRequestValidationError{}
+
+// Validate checks the field values on ListImportJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListImportJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListImportJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListImportJobsResponseMultiError, or nil if none found.
+func (m *ListImportJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListImportJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListImportJobsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListImportJobsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListImportJobsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListImportJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListImportJobsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListImportJobsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListImportJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListImportJobsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListImportJobsResponseMultiError) AllErrors() []error { return m }
+
+// ListImportJobsResponseValidationError is the validation error returned by
+// ListImportJobsResponse.Validate if the designated constraints aren't met.
+type ListImportJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListImportJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListImportJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListImportJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListImportJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListImportJobsResponseValidationError) ErrorName() string {
+	return "ListImportJobsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListImportJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListImportJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListImportJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListImportJobsResponseValidationError{}
+
+// Validate checks the field values on ListPortsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPortsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPortsRequestMultiError, or nil if none found.
+func (m *ListPortsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPortsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListPortsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for Country
+
+	// no validation rules for Province
+
+	// no validation rules for Region
+
+	// no validation rules for Timezone
+
+	// no validation rules for CodePrefix
+
+	if _, ok := _ListPortsRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
+		err := ListPortsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be in list [ id slug name city country]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Descending
+
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPortsRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPortsRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPortsRequestValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListPortsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPortsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPortsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPortsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPortsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPortsRequestMultiError) AllErrors() []error { return m }
+
+// ListPortsRequestValidationError is the validation error returned by
+// ListPortsRequest.Validate if the designated constraints aren't met.
+type ListPortsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPortsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPortsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPortsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPortsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPortsRequestValidationError) ErrorName() string { return "ListPortsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPortsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPortsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPortsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPortsRequestValidationError{}
+
+var _ListPortsRequest_OrderBy_InLookup = map[string]struct{}{
+	"":        {},
+	"id":      {},
+	"slug":    {},
+	"name":    {},
+	"city":    {},
+	"country": {},
+}
+
+// Validate checks the field values on ExportPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPortsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPortsRequestMultiError, or nil if none found.
+func (m *ExportPortsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPortsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Country
+
+	// no validation rules for Province
+
+	// no validation rules for Region
+
+	// no validation rules for Timezone
+
+	// no validation rules for CodePrefix
+
+	if _, ok := _ExportPortsRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
+		err := ExportPortsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be in list [ id slug name city country]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Descending
+
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportPortsRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportPortsRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportPortsRequestValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportPortsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPortsRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportPortsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportPortsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPortsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPortsRequestMultiError) AllErrors() []error { return m }
+
+// ExportPortsRequestValidationError is the validation error returned by
+// ExportPortsRequest.Validate if the designated constraints aren't met.
+type ExportPortsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPortsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPortsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPortsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPortsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPortsRequestValidationError) ErrorName() string {
+	return "ExportPortsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPortsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPortsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPortsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPortsRequestValidationError{}
+
+var _ExportPortsRequest_OrderBy_InLookup = map[string]struct{}{
+	"":        {},
+	"id":      {},
+	"slug":    {},
+	"name":    {},
+	"city":    {},
+	"country": {},
+}
+
+// Validate checks the field values on ExportPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPortsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPortsResponseMultiError, or nil if none found.
+func (m *ExportPortsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPortsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportPortsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportPortsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPortsResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportPortsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPortsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPortsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPortsResponseMultiError) AllErrors() []error { return m }
+
+// ExportPortsResponseValidationError is the validation error returned by
+// ExportPortsResponse.Validate if the designated constraints aren't met.
+type ExportPortsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPortsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPortsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPortsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPortsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPortsResponseValidationError) ErrorName() string {
+	return "ExportPortsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPortsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPortsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPortsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPortsResponseValidationError{}
+
+// Validate checks the field values on ListPortsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPortsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPortsResponseMultiError, or nil if none found.
+func (m *ListPortsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPortsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPortsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPortsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPortsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPortsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPortsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPortsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPortsResponseMultiError) AllErrors() []error { return m }
+
+// ListPortsResponseValidationError is the validation error returned by
+// ListPortsResponse.Validate if the designated constraints aren't met.
+type ListPortsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPortsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPortsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPortsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPortsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPortsResponseValidationError) ErrorName() string {
+	return "ListPortsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPortsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPortsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPortsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPortsResponseValidationError{}
+
+// Validate checks the field values on Port with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Port) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Port with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PortMultiError, or nil if none found.
+func (m *Port) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Port) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSlug()) != 5 {
+		err := PortValidationError{
+			field:  "Slug",
+			reason: "value length must be 5 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_Port_Slug_Pattern.MatchString(m.GetSlug()) {
+		err := PortValidationError{
+			field:  "Slug",
+			reason: "value does not match regex pattern \"^[A-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for City
+
+	// no validation rules for Province
+
+	// no validation rules for Country
+
+	if all {
+		switch v := interface{}(m.GetCoordinates()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortValidationError{
+					field:  "Coordinates",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortValidationError{
+					field:  "Coordinates",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortValidationError{
+				field:  "Coordinates",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	// no validation rules for Code
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return PortMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortMultiError is an error wrapping multiple validation errors returned by
+// Port.ValidateAll() if the designated constraints aren't met.
+type PortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortMultiError) AllErrors() []error { return m }
+
+// PortValidationError is the validation error returned by Port.Validate if the
+// designated constraints aren't met.
+type PortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortValidationError) ErrorName() string { return "PortValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PortValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -527,7 +4664,1494 @@ func (e PortValidationError) Error() string {
 		cause)
 }
 
-var _ error = PortValidationError{}
+var _ error = PortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortValidationError{}
+
+var _Port_Slug_Pattern = regexp.MustCompile("^[A-Z0-9]+$")
+
+// Validate checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Coordinates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CoordinatesMultiError, or
+// nil if none found.
+func (m *Coordinates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Coordinates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLng(); val < -180 || val > 180 {
+		err := CoordinatesValidationError{
+			field:  "Lng",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLat(); val < -90 || val > 90 {
+		err := CoordinatesValidationError{
+			field:  "Lat",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CoordinatesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CoordinatesMultiError is an error wrapping multiple validation errors
+// returned by Coordinates.ValidateAll() if the designated constraints aren't met.
+type CoordinatesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CoordinatesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CoordinatesMultiError) AllErrors() []error { return m }
+
+// CoordinatesValidationError is the validation error returned by
+// Coordinates.Validate if the designated constraints aren't met.
+type CoordinatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CoordinatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CoordinatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CoordinatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CoordinatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CoordinatesValidationError) ErrorName() string { return "CoordinatesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CoordinatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCoordinates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CoordinatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CoordinatesValidationError{}
+
+// Validate checks the field values on PortResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PortResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PortResponseMultiError, or
+// nil if none found.
+func (m *PortResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PortResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PortResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PortResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PortResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortResponseMultiError is an error wrapping multiple validation errors
+// returned by PortResponse.ValidateAll() if the designated constraints aren't met.
+type PortResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortResponseMultiError) AllErrors() []error { return m }
+
+// PortResponseValidationError is the validation error returned by
+// PortResponse.Validate if the designated constraints aren't met.
+type PortResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortResponseValidationError) ErrorName() string { return "PortResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PortResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortResponseValidationError{}
+
+// Validate checks the field values on NearestPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NearestPortsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NearestPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NearestPortsRequestMultiError, or nil if none found.
+func (m *NearestPortsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NearestPortsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLocation() == nil {
+		err := NearestPortsRequestValidationError{
+			field:  "Location",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NearestPortsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NearestPortsRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NearestPortsRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetRadiusKm(); val < 0 || val > 20040 {
+		err := NearestPortsRequestValidationError{
+			field:  "RadiusKm",
+			reason: "value must be inside range [0, 20040]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := NearestPortsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Country
+
+	if len(errors) > 0 {
+		return NearestPortsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// NearestPortsRequestMultiError is an error wrapping multiple validation
+// errors returned by NearestPortsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type NearestPortsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NearestPortsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NearestPortsRequestMultiError) AllErrors() []error { return m }
+
+// NearestPortsRequestValidationError is the validation error returned by
+// NearestPortsRequest.Validate if the designated constraints aren't met.
+type NearestPortsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NearestPortsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NearestPortsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NearestPortsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NearestPortsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NearestPortsRequestValidationError) ErrorName() string {
+	return "NearestPortsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NearestPortsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNearestPortsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NearestPortsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NearestPortsRequestValidationError{}
+
+// Validate checks the field values on NearestPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NearestPortsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NearestPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NearestPortsResponseMultiError, or nil if none found.
+func (m *NearestPortsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NearestPortsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NearestPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NearestPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NearestPortsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NearestPortsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// NearestPortsResponseMultiError is an error wrapping multiple validation
+// errors returned by NearestPortsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type NearestPortsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NearestPortsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NearestPortsResponseMultiError) AllErrors() []error { return m }
+
+// NearestPortsResponseValidationError is the validation error returned by
+// NearestPortsResponse.Validate if the designated constraints aren't met.
+type NearestPortsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NearestPortsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NearestPortsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NearestPortsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NearestPortsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NearestPortsResponseValidationError) ErrorName() string {
+	return "NearestPortsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NearestPortsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNearestPortsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NearestPortsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NearestPortsResponseValidationError{}
+
+// Validate checks the field values on PortDistance with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PortDistance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortDistance with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PortDistanceMultiError, or
+// nil if none found.
+func (m *PortDistance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortDistance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPort()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortDistanceValidationError{
+					field:  "Port",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortDistanceValidationError{
+					field:  "Port",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPort()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortDistanceValidationError{
+				field:  "Port",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DistanceKm
+
+	if len(errors) > 0 {
+		return PortDistanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortDistanceMultiError is an error wrapping multiple validation errors
+// returned by PortDistance.ValidateAll() if the designated constraints aren't met.
+type PortDistanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortDistanceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortDistanceMultiError) AllErrors() []error { return m }
+
+// PortDistanceValidationError is the validation error returned by
+// PortDistance.Validate if the designated constraints aren't met.
+type PortDistanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortDistanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortDistanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortDistanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortDistanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortDistanceValidationError) ErrorName() string { return "PortDistanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PortDistanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortDistance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortDistanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortDistanceValidationError{}
+
+// Validate checks the field values on SearchPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPortsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPortsRequestMultiError, or nil if none found.
+func (m *SearchPortsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPortsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQ()); l < 1 || l > 200 {
+		err := SearchPortsRequestValidationError{
+			field:  "Q",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := SearchPortsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchPortsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPortsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchPortsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPortsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPortsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPortsRequestMultiError) AllErrors() []error { return m }
+
+// SearchPortsRequestValidationError is the validation error returned by
+// SearchPortsRequest.Validate if the designated constraints aren't met.
+type SearchPortsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPortsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPortsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPortsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPortsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPortsRequestValidationError) ErrorName() string {
+	return "SearchPortsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPortsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPortsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPortsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPortsRequestValidationError{}
+
+// Validate checks the field values on SearchPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPortsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPortsResponseMultiError, or nil if none found.
+func (m *SearchPortsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPortsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPortsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchPortsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPortsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchPortsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchPortsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPortsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPortsResponseMultiError) AllErrors() []error { return m }
+
+// SearchPortsResponseValidationError is the validation error returned by
+// SearchPortsResponse.Validate if the designated constraints aren't met.
+type SearchPortsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPortsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPortsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPortsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPortsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPortsResponseValidationError) ErrorName() string {
+	return "SearchPortsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPortsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPortsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPortsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPortsResponseValidationError{}
+
+// Validate checks the field values on PortMatch with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PortMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PortMatchMultiError, or nil
+// if none found.
+func (m *PortMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPort()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortMatchValidationError{
+					field:  "Port",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortMatchValidationError{
+					field:  "Port",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPort()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortMatchValidationError{
+				field:  "Port",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return PortMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortMatchMultiError is an error wrapping multiple validation errors returned
+// by PortMatch.ValidateAll() if the designated constraints aren't met.
+type PortMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortMatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortMatchMultiError) AllErrors() []error { return m }
+
+// PortMatchValidationError is the validation error returned by
+// PortMatch.Validate if the designated constraints aren't met.
+type PortMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortMatchValidationError) ErrorName() string { return "PortMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PortMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortMatchValidationError{}
+
+// Validate checks the field values on PortRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PortRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PortRequestMultiError, or
+// nil if none found.
+func (m *PortRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortRequestValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortRequestValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortRequestValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if wrapper := m.GetSlug(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) != 5 {
+			err := PortRequestValidationError{
+				field:  "Slug",
+				reason: "value length must be 5 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_PortRequest_Slug_Pattern.MatchString(wrapper.GetValue()) {
+			err := PortRequestValidationError{
+				field:  "Slug",
+				reason: "value does not match regex pattern \"^[A-Z0-9]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortRequestValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpectedVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortRequestValidationError{
+					field:  "ExpectedVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortRequestValidationError{
+					field:  "ExpectedVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpectedVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PortRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortRequestMultiError is an error wrapping multiple validation errors
+// returned by PortRequest.ValidateAll() if the designated constraints aren't met.
+type PortRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortRequestMultiError) AllErrors() []error { return m }
+
+// PortRequestValidationError is the validation error returned by
+// PortRequest.Validate if the designated constraints aren't met.
+type PortRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortRequestValidationError) ErrorName() string { return "PortRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PortRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortRequestValidationError{}
+
+var _PortRequest_Slug_Pattern = regexp.MustCompile("^[A-Z0-9]+$")
+
+// Validate checks the field values on PortKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PortKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PortKeyMultiError, or nil if none found.
+func (m *PortKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch m.Key.(type) {
+
+	case *PortKey_Id:
+		// no validation rules for Id
+
+	case *PortKey_Slug:
+
+		if utf8.RuneCountInString(m.GetSlug()) != 5 {
+			err := PortKeyValidationError{
+				field:  "Slug",
+				reason: "value length must be 5 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_PortKey_Slug_Pattern.MatchString(m.GetSlug()) {
+			err := PortKeyValidationError{
+				field:  "Slug",
+				reason: "value does not match regex pattern \"^[A-Z0-9]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *PortKey_Unlock:
+
+		if utf8.RuneCountInString(m.GetUnlock()) < 1 {
+			err := PortKeyValidationError{
+				field:  "Unlock",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		err := PortKeyValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return PortKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortKeyMultiError is an error wrapping multiple validation errors returned
+// by PortKey.ValidateAll() if the designated constraints aren't met.
+type PortKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortKeyMultiError) AllErrors() []error { return m }
+
+// PortKeyValidationError is the validation error returned by PortKey.Validate
+// if the designated constraints aren't met.
+type PortKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortKeyValidationError) ErrorName() string { return "PortKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PortKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -535,38 +6159,247 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PortValidationError{}
+} = PortKeyValidationError{}
 
-var _Port_Slug_Pattern = regexp.MustCompile("^[A-Z0-9]+$")
+var _PortKey_Slug_Pattern = regexp.MustCompile("^[A-Z0-9]+$")
 
-// Validate checks the field values on Coordinates with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *Coordinates) Validate() error {
+// Validate checks the field values on BatchGetPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetPortsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetPortsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetPortsRequestMultiError, or nil if none found.
+func (m *BatchGetPortsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetPortsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if val := m.GetLng(); val < -180 || val > 180 {
-		return CoordinatesValidationError{
-			field:  "Lng",
-			reason: "value must be inside range [-180, 180]",
+	var errors []error
+
+	if l := len(m.GetKeys()); l < 1 || l > 1000 {
+		err := BatchGetPortsRequestValidationError{
+			field:  "Keys",
+			reason: "value must contain between 1 and 1000 items, inclusive",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if val := m.GetLat(); val < -90 || val > 90 {
-		return CoordinatesValidationError{
-			field:  "Lat",
-			reason: "value must be inside range [-90, 90]",
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		// skipping validation for keys
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetPortsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetPortsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetPortsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetPortsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetPortsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetPortsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetPortsRequestValidationError is the validation error returned by
+// BatchGetPortsRequest.Validate if the designated constraints aren't met.
+type BatchGetPortsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetPortsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetPortsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetPortsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetPortsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetPortsRequestValidationError) ErrorName() string {
+	return "BatchGetPortsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetPortsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetPortsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetPortsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetPortsRequestValidationError{}
+
+// Validate checks the field values on BatchGetPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetPortsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetPortsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetPortsResponseMultiError, or nil if none found.
+func (m *BatchGetPortsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetPortsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetPortsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetPortsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetNotFound() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetPortsResponseValidationError{
+						field:  fmt.Sprintf("NotFound[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetPortsResponseValidationError{
+						field:  fmt.Sprintf("NotFound[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetPortsResponseValidationError{
+					field:  fmt.Sprintf("NotFound[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetPortsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CoordinatesValidationError is the validation error returned by
-// Coordinates.Validate if the designated constraints aren't met.
-type CoordinatesValidationError struct {
+// BatchGetPortsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetPortsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetPortsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetPortsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetPortsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetPortsResponseValidationError is the validation error returned by
+// BatchGetPortsResponse.Validate if the designated constraints aren't met.
+type BatchGetPortsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -574,22 +6407,182 @@ type CoordinatesValidationError struct {
 }
 
 // Field function returns field value.
-func (e CoordinatesValidationError) Field() string { return e.field }
+func (e BatchGetPortsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CoordinatesValidationError) Reason() string { return e.reason }
+func (e BatchGetPortsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CoordinatesValidationError) Cause() error { return e.cause }
+func (e BatchGetPortsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CoordinatesValidationError) Key() bool { return e.key }
+func (e BatchGetPortsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CoordinatesValidationError) ErrorName() string { return "CoordinatesValidationError" }
+func (e BatchGetPortsResponseValidationError) ErrorName() string {
+	return "BatchGetPortsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CoordinatesValidationError) Error() string {
+func (e BatchGetPortsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetPortsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetPortsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetPortsResponseValidationError{}
+
+// Validate checks the field values on PortHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PortHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PortHistoryRequestMultiError, or nil if none found.
+func (m *PortHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortHistoryRequestValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortHistoryRequestValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortHistoryRequestValidationError{
+				field:  "Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if wrapper := m.GetSlug(); wrapper != nil {
+
+		if utf8.RuneCountInString(wrapper.GetValue()) != 5 {
+			err := PortHistoryRequestValidationError{
+				field:  "Slug",
+				reason: "value length must be 5 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_PortHistoryRequest_Slug_Pattern.MatchString(wrapper.GetValue()) {
+			err := PortHistoryRequestValidationError{
+				field:  "Slug",
+				reason: "value does not match regex pattern \"^[A-Z0-9]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PortHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortHistoryRequestMultiError is an error wrapping multiple validation errors
+// returned by PortHistoryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PortHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortHistoryRequestMultiError) AllErrors() []error { return m }
+
+// PortHistoryRequestValidationError is the validation error returned by
+// PortHistoryRequest.Validate if the designated constraints aren't met.
+type PortHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortHistoryRequestValidationError) ErrorName() string {
+	return "PortHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PortHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -601,14 +6594,14 @@ func (e CoordinatesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCoordinates.%s: %s%s",
+		"invalid %sPortHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CoordinatesValidationError{}
+var _ error = PortHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -616,36 +6609,97 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CoordinatesValidationError{}
+} = PortHistoryRequestValidationError{}
 
-// Validate checks the field values on PortResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *PortResponse) Validate() error {
+var _PortHistoryRequest_Slug_Pattern = regexp.MustCompile("^[A-Z0-9]+$")
+
+// Validate checks the field values on PortHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PortHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PortHistoryResponseMultiError, or nil if none found.
+func (m *PortHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	// no validation rules for StatusCode
 
 	// no validation rules for Message
 
-	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PortResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PortHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PortHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PortHistoryResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
+	}
+
+	if len(errors) > 0 {
+		return PortHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PortResponseValidationError is the validation error returned by
-// PortResponse.Validate if the designated constraints aren't met.
-type PortResponseValidationError struct {
+// PortHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by PortHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PortHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortHistoryResponseMultiError) AllErrors() []error { return m }
+
+// PortHistoryResponseValidationError is the validation error returned by
+// PortHistoryResponse.Validate if the designated constraints aren't met.
+type PortHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -653,22 +6707,24 @@ type PortResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PortResponseValidationError) Field() string { return e.field }
+func (e PortHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PortResponseValidationError) Reason() string { return e.reason }
+func (e PortHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PortResponseValidationError) Cause() error { return e.cause }
+func (e PortHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PortResponseValidationError) Key() bool { return e.key }
+func (e PortHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PortResponseValidationError) ErrorName() string { return "PortResponseValidationError" }
+func (e PortHistoryResponseValidationError) ErrorName() string {
+	return "PortHistoryResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PortResponseValidationError) Error() string {
+func (e PortHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -680,14 +6736,14 @@ func (e PortResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPortResponse.%s: %s%s",
+		"invalid %sPortHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PortResponseValidationError{}
+var _ error = PortHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -695,51 +6751,149 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PortResponseValidationError{}
+} = PortHistoryResponseValidationError{}
 
-// Validate checks the field values on PortRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *PortRequest) Validate() error {
+// Validate checks the field values on PortChange with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PortChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PortChangeMultiError, or
+// nil if none found.
+func (m *PortChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetOldValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortChangeValidationError{
+					field:  "OldValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortChangeValidationError{
+					field:  "OldValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOldValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortChangeValidationError{
+				field:  "OldValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNewValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortChangeValidationError{
+					field:  "NewValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortChangeValidationError{
+					field:  "NewValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewValue()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PortRequestValidationError{
-				field:  "Id",
+			return PortChangeValidationError{
+				field:  "NewValue",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if wrapper := m.GetSlug(); wrapper != nil {
+	// no validation rules for Actor
 
-		if utf8.RuneCountInString(wrapper.GetValue()) != 5 {
-			return PortRequestValidationError{
-				field:  "Slug",
-				reason: "value length must be 5 runes",
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-
 		}
-
-		if !_PortRequest_Slug_Pattern.MatchString(wrapper.GetValue()) {
-			return PortRequestValidationError{
-				field:  "Slug",
-				reason: "value does not match regex pattern \"^[A-Z]+$\"",
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PortChangeValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return PortChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// PortRequestValidationError is the validation error returned by
-// PortRequest.Validate if the designated constraints aren't met.
-type PortRequestValidationError struct {
+// PortChangeMultiError is an error wrapping multiple validation errors
+// returned by PortChange.ValidateAll() if the designated constraints aren't met.
+type PortChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortChangeMultiError) AllErrors() []error { return m }
+
+// PortChangeValidationError is the validation error returned by
+// PortChange.Validate if the designated constraints aren't met.
+type PortChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -747,22 +6901,22 @@ type PortRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PortRequestValidationError) Field() string { return e.field }
+func (e PortChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PortRequestValidationError) Reason() string { return e.reason }
+func (e PortChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PortRequestValidationError) Cause() error { return e.cause }
+func (e PortChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PortRequestValidationError) Key() bool { return e.key }
+func (e PortChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PortRequestValidationError) ErrorName() string { return "PortRequestValidationError" }
+func (e PortChangeValidationError) ErrorName() string { return "PortChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PortRequestValidationError) Error() string {
+func (e PortChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -774,14 +6928,14 @@ func (e PortRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPortRequest.%s: %s%s",
+		"invalid %sPortChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PortRequestValidationError{}
+var _ error = PortChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -789,19 +6943,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PortRequestValidationError{}
-
-var _PortRequest_Slug_Pattern = regexp.MustCompile("^[A-Z]+$")
+} = PortChangeValidationError{}
 
 // Validate checks the field values on UpdatePortRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
 func (m *UpdatePortRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePortRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePortRequestMultiError, or nil if none found.
+func (m *UpdatePortRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePortRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetId()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePortRequestValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePortRequestValidationError{
+					field:  "Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetId()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return UpdatePortRequestValidationError{
 				field:  "Id",
@@ -814,30 +6999,61 @@ func (m *UpdatePortRequest) Validate() error {
 	if wrapper := m.GetSlug(); wrapper != nil {
 
 		if utf8.RuneCountInString(wrapper.GetValue()) != 5 {
-			return UpdatePortRequestValidationError{
+			err := UpdatePortRequestValidationError{
 				field:  "Slug",
 				reason: "value length must be 5 runes",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 
 		}
 
 		if !_UpdatePortRequest_Slug_Pattern.MatchString(wrapper.GetValue()) {
-			return UpdatePortRequestValidationError{
+			err := UpdatePortRequestValidationError{
 				field:  "Slug",
-				reason: "value does not match regex pattern \"^[A-Z]+$\"",
+				reason: "value does not match regex pattern \"^[A-Z0-9]+$\"",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
 
 	}
 
 	if m.GetData() == nil {
-		return UpdatePortRequestValidationError{
+		err := UpdatePortRequestValidationError{
 			field:  "Data",
 			reason: "value is required",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePortRequestValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePortRequestValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return UpdatePortRequestValidationError{
 				field:  "Data",
@@ -847,9 +7063,88 @@ func (m *UpdatePortRequest) Validate() error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetExpectedVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePortRequestValidationError{
+					field:  "ExpectedVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePortRequestValidationError{
+					field:  "ExpectedVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpectedVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePortRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePortRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePortRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePortRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePortRequestMultiError(errors)
+	}
+
 	return nil
 }
 
+// UpdatePortRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdatePortRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePortRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePortRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePortRequestMultiError) AllErrors() []error { return m }
+
 // UpdatePortRequestValidationError is the validation error returned by
 // UpdatePortRequest.Validate if the designated constraints aren't met.
 type UpdatePortRequestValidationError struct {
@@ -906,17 +7201,50 @@ var _ interface {
 	ErrorName() string
 } = UpdatePortRequestValidationError{}
 
-var _UpdatePortRequest_Slug_Pattern = regexp.MustCompile("^[A-Z]+$")
+var _UpdatePortRequest_Slug_Pattern = regexp.MustCompile("^[A-Z0-9]+$")
 
 // Validate checks the field values on PortUpdatable with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
 func (m *PortUpdatable) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortUpdatable with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PortUpdatableMultiError, or
+// nil if none found.
+func (m *PortUpdatable) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortUpdatable) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PortUpdatableValidationError{
 				field:  "Name",
@@ -926,7 +7254,26 @@ func (m *PortUpdatable) Validate() error {
 		}
 	}
 
-	if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetCity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "City",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCity()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PortUpdatableValidationError{
 				field:  "City",
@@ -936,7 +7283,26 @@ func (m *PortUpdatable) Validate() error {
 		}
 	}
 
-	if v, ok := interface{}(m.GetProvince()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetProvince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Province",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Province",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProvince()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PortUpdatableValidationError{
 				field:  "Province",
@@ -946,7 +7312,26 @@ func (m *PortUpdatable) Validate() error {
 		}
 	}
 
-	if v, ok := interface{}(m.GetCountry()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetCountry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Country",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Country",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCountry()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PortUpdatableValidationError{
 				field:  "Country",
@@ -956,7 +7341,26 @@ func (m *PortUpdatable) Validate() error {
 		}
 	}
 
-	if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetCoordinates()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Coordinates",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Coordinates",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PortUpdatableValidationError{
 				field:  "Coordinates",
@@ -966,7 +7370,26 @@ func (m *PortUpdatable) Validate() error {
 		}
 	}
 
-	if v, ok := interface{}(m.GetTimezone()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetTimezone()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Timezone",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Timezone",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimezone()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PortUpdatableValidationError{
 				field:  "Timezone",
@@ -976,7 +7399,26 @@ func (m *PortUpdatable) Validate() error {
 		}
 	}
 
-	if v, ok := interface{}(m.GetCode()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetCode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Code",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PortUpdatableValidationError{
+					field:  "Code",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCode()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return PortUpdatableValidationError{
 				field:  "Code",
@@ -986,9 +7428,30 @@ func (m *PortUpdatable) Validate() error {
 		}
 	}
 
+	if len(errors) > 0 {
+		return PortUpdatableMultiError(errors)
+	}
+
 	return nil
 }
 
+// PortUpdatableMultiError is an error wrapping multiple validation errors
+// returned by PortUpdatable.ValidateAll() if the designated constraints
+// aren't met.
+type PortUpdatableMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortUpdatableMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortUpdatableMultiError) AllErrors() []error { return m }
+
 // PortUpdatableValidationError is the validation error returned by
 // PortUpdatable.Validate if the designated constraints aren't met.
 type PortUpdatableValidationError struct {
@@ -7,6 +7,9 @@ readonly _DOCKER_IMAGE=go-proto-builder
 
 readonly _GO_PB_PATH=github.com/golang/protobuf
 readonly _VALIDATE_PATH=github.com/envoyproxy/protoc-gen-validate
+# the generated ValidateAll methods need the newer generator than the validate module of go.mod,
+# the generated code does not depend on the module version
+readonly _VALIDATE_GENERATOR_VERSION=v0.6.7
 
 function main {
     if [ -z "${TRANSSHIPMENT_ROOT}" ]; then
@@ -43,7 +46,7 @@ function _build_docker {
     fi
 
     if [[ -z "${ENVOYPROXY_PROTOC_GEN_VALIDATE_VERSION}" ]]; then
-        local validate_version=$(go list -f '{{ .Version }}' -m "${_VALIDATE_PATH}")
+        local validate_version="${_VALIDATE_GENERATOR_VERSION}"
     else
         local validate_version="${ENVOYPROXY_PROTOC_GEN_VALIDATE_VERSION}"
         echo "override set for ${_VALIDATE_PATH}: ${validate_version}" >&2
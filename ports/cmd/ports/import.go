@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/kreyyser/transshipment/common/actor"
+	"github.com/kreyyser/transshipment/common/portfile"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/kreyyser/transshipment/ports/internal/services/portentries"
+	"github.com/spf13/pflag"
+	"os"
+	"strings"
+)
+
+const (
+	// importBufferSize is the number of bytes read from the imported file at once
+	importBufferSize = 64 << 10
+	// importChunkSize is the number of ports staged at once
+	importChunkSize = 500
+)
+
+const importUsage = "usage: import [--format format] [--mode upsert|sync] [--loader insert|copy] [--conflict-policy policy] [--field-policies field:policy,...] [--dry-run] [--slug-property name] [--actor name] file"
+
+// filePort is the port as the imported files have it
+type filePort struct {
+	Name        string    `json:"name"`
+	City        string    `json:"city"`
+	Province    string    `json:"province"`
+	Country     string    `json:"country"`
+	Alias       []string  `json:"alias"`
+	Regions     []string  `json:"regions"`
+	Coordinates []float64 `json:"coordinates"`
+	Timezone    string    `json:"timezone"`
+	Unlocks     []string  `json:"unlocks"`
+	Code        string    `json:"code"`
+}
+
+// runImport handles "import [flags] file" subcommand, the file is imported at once the way the gateway upload does
+func runImport(server *Server, args []string) error {
+	flags := pflag.NewFlagSet("import", pflag.ContinueOnError)
+	format := flags.String("format", "", "one of "+strings.Join(portfile.Formats, ", ")+", detected when not set")
+	mode := flags.String("mode", "upsert", "upsert keeps the ports missing from the file, sync deletes them")
+	loaderName := flags.String("loader", "insert", "copy loads the ports with COPY, which is much faster for the whole dataset")
+	conflictPolicy := flags.String("conflict-policy", "overwrite", "how the stored ports are changed, one of overwrite, skip_existing, fill_empty_only or fail_on_conflict")
+	fieldPolicies := flags.String("field-policies", "", "policies of single fields overriding the conflict policy, like timezone:skip_existing")
+	dryRun := flags.Bool("dry-run", false, "only count the changes without writing anything")
+	slugProperty := flags.String("slug-property", "", "property or column the slugs are read from, slug by default")
+	actorName := flags.String("actor", "ports-cli", "actor the changes are recorded in the history with")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return errors.New(importUsage)
+	}
+
+	if !portfile.Valid(*format) {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	var importMode pb.ImportMode
+	switch *mode {
+	case "upsert":
+		importMode = pb.ImportMode_IMPORT_MODE_UPSERT
+	case "sync":
+		importMode = pb.ImportMode_IMPORT_MODE_SYNC
+	default:
+		return errors.New("mode should be either upsert or sync")
+	}
+
+	var loader pb.ImportLoader
+	switch *loaderName {
+	case "insert":
+		loader = pb.ImportLoader_IMPORT_LOADER_INSERT
+	case "copy":
+		loader = pb.ImportLoader_IMPORT_LOADER_COPY
+	default:
+		return errors.New("loader should be either insert or copy")
+	}
+
+	conflicts, err := parseConflicts(*conflictPolicy, *fieldPolicies)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	ctx := actor.NewContext(context.Background(), *actorName)
+	service := portentries.NewPortsService(server.PortsDB, server.Validator)
+
+	imp, err := service.StartPortsImport(ctx, &pb.StartPortsImportRequest{Loader: loader})
+	if err != nil {
+		return err
+	}
+
+	abort := func(err error) error {
+		_, _ = service.AbortPortsImport(ctx, &pb.PortsImportRequest{ImportId: imp.ImportId})
+
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return abort(err)
+	}
+
+	parts, err := portfile.Parts(file, info.Size(), file.Name())
+	if err != nil {
+		return abort(fmt.Errorf("invalid file: %s", err))
+	}
+
+	var processed, failed, warned int64
+	for _, part := range parts {
+		opts := portfile.Options{
+			Format:       *format,
+			FileName:     part.Name,
+			SlugProperty: *slugProperty,
+			BufferSize:   importBufferSize,
+			ChunkSize:    importChunkSize,
+		}
+
+		p, f, w, err := stagePart(ctx, service, imp.ImportId, part, opts)
+		processed += p
+		failed += f
+		warned += w
+		if err != nil {
+			return abort(err)
+		}
+	}
+
+	if importMode == pb.ImportMode_IMPORT_MODE_SYNC && failed > 0 {
+		return abort(fmt.Errorf("sync needs every port of the file, %d ports failed", failed))
+	}
+
+	summary, err := service.FinishPortsImport(ctx, &pb.FinishPortsImportRequest{
+		ImportId:  imp.ImportId,
+		Mode:      importMode,
+		DryRun:    *dryRun,
+		Conflicts: conflicts,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("processed %d, failed %d, warned %d, created %d, updated %d, unchanged %d, removed %d\n",
+		processed, failed, warned, summary.Created, summary.Updated, summary.Unchanged, summary.Removed)
+	if summary.DryRun {
+		fmt.Println("dry run, nothing has been changed")
+	}
+
+	return nil
+}
+
+// stagePart stages the ports of the file part in the import and prints the failed and the warned ones,
+// it returns the number of the processed, the failed and the warned ports
+func stagePart(ctx context.Context, service *portentries.Service, importID string, part portfile.Part, opts portfile.Options) (int64, int64, int64, error) {
+	r, err := part.Open()
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid file %s: %s", part.Name, err)
+	}
+	defer func() { _ = r.Close() }()
+
+	// the records of the zip archive are located by their file as well
+	at := ""
+	if part.Archived {
+		at = part.Name + ":"
+	}
+
+	stream := portfile.Parse(ctx, r, opts, func() interface{} { return new(filePort) })
+	defer stream.Close()
+
+	var processed, failed, warned int64
+	for entries, ok := stream.Next(); ok; entries, ok = stream.Next() {
+		ports := make([]*pb.Port, 0, len(entries))
+		offsets := make([]int64, 0, len(entries))
+		for _, entry := range entries {
+			processed++
+
+			if entry.Err != nil {
+				failed++
+				fmt.Printf("failed %s at %s%d: %v\n", entry.Key, at, entry.Offset, entry.Err)
+				continue
+			}
+
+			ports = append(ports, entry.Value.(*filePort).toPb(entry.Key))
+			offsets = append(offsets, entry.Offset)
+		}
+
+		if len(ports) == 0 {
+			continue
+		}
+
+		res, err := service.StagePortsImport(ctx, &pb.StagePortsImportRequest{ImportId: importID, Data: ports})
+		if err != nil {
+			return processed, failed, warned, err
+		}
+
+		for _, result := range res.Failed {
+			failed++
+			for _, e := range result.Errors {
+				fmt.Printf("failed %s at %s%d: %s %s\n", result.Slug, at, offsets[result.Index], e.Field, e.Reason)
+			}
+		}
+
+		for _, result := range res.Warned {
+			warned++
+			for _, e := range result.Warnings {
+				fmt.Printf("warning %s at %s%d: %s %s\n", result.Slug, at, offsets[result.Index], e.Field, e.Reason)
+			}
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		return processed, failed, warned, fmt.Errorf("invalid file %s: %s", part.Name, err)
+	}
+
+	return processed, failed, warned, nil
+}
+
+func (p *filePort) toPb(slug string) *pb.Port {
+	proto := &pb.Port{
+		Slug:     slug,
+		Name:     p.Name,
+		City:     p.City,
+		Province: p.Province,
+		Country:  p.Country,
+		Alias:    p.Alias,
+		Regions:  p.Regions,
+		Timezone: p.Timezone,
+		Unlocks:  p.Unlocks,
+		Code:     p.Code,
+	}
+
+	if len(p.Coordinates) == 2 {
+		proto.Coordinates = &pb.Coordinates{
+			Lng: p.Coordinates[0],
+			Lat: p.Coordinates[1],
+		}
+	}
+
+	return proto
+}
+
+// parseConflicts reads the conflict policy and the comma separated pairs of the field and its policy
+func parseConflicts(policy string, fieldPolicies string) (*pb.Conflicts, error) {
+	conflicts := &pb.Conflicts{}
+
+	var err error
+	if conflicts.Policy, err = parseConflictPolicy(policy); err != nil {
+		return nil, err
+	}
+
+	for _, pair := range strings.Split(fieldPolicies, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("field policies should be pairs of the field and its policy, got %s", pair)
+		}
+
+		if conflicts.FieldPolicies == nil {
+			conflicts.FieldPolicies = map[string]pb.ConflictPolicy{}
+		}
+		if conflicts.FieldPolicies[strings.TrimSpace(parts[0])], err = parseConflictPolicy(parts[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	return conflicts, nil
+}
+
+// parseConflictPolicy reads the policy by its name, like fill_empty_only
+func parseConflictPolicy(name string) (pb.ConflictPolicy, error) {
+	policy, ok := pb.ConflictPolicy_value["CONFLICT_POLICY_"+strings.ToUpper(strings.TrimSpace(name))]
+	if !ok {
+		return 0, errors.New("conflict policy should be one of overwrite, skip_existing, fill_empty_only or fail_on_conflict")
+	}
+
+	return pb.ConflictPolicy(policy), nil
+}
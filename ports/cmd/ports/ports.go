@@ -22,6 +22,8 @@ func run() (int, interface{}) {
 	// Handle command line options
 	flagset := pflag.NewFlagSet("ports", pflag.ExitOnError)
 	configPath := flagset.StringP("config", "c", "", "Path to transhipment config yaml")
+	// the flags after the subcommand are its own
+	flagset.SetInterspersed(false)
 	_ = flagset.Parse(os.Args[1:])
 
 	// Transhipment config file should exist in the file system
@@ -45,6 +47,40 @@ func run() (int, interface{}) {
 		return initError, fmt.Sprintf("failed to load stores: %s", err)
 	}
 
+	// Setup the domain validation of the ports
+	if err := server.LoadValidator(); err != nil {
+		return initError, fmt.Sprintf("failed to load validator: %s", err)
+	}
+
+	// Run the subcommand instead of the server when given
+	if args := flagset.Args(); len(args) > 0 {
+		defer server.Shutdown()
+
+		switch args[0] {
+		case "migrate":
+			if err := runMigrate(server, args[1:]); err != nil {
+				return runtimeError, fmt.Sprintf("failed to migrate: %s", err)
+			}
+		case "import":
+			if err := server.CheckSchema(); err != nil {
+				return initError, fmt.Sprintf("failed to check schema: %s", err)
+			}
+
+			if err := runImport(server, args[1:]); err != nil {
+				return runtimeError, fmt.Sprintf("failed to import: %s", err)
+			}
+		default:
+			return initError, fmt.Sprintf("unknown command %q", args[0])
+		}
+
+		return success, "all good"
+	}
+
+	// Make sure db schema is in the expected state
+	if err := server.CheckSchema(); err != nil {
+		return initError, fmt.Sprintf("failed to check schema: %s", err)
+	}
+
 	// Setup the configured Ports Services
 	if err := server.LoadServices(); err != nil {
 		return initError, fmt.Sprintf("failed to load services: %s", err)
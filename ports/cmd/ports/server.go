@@ -1,15 +1,23 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/kreyyser/transshipment/common/actor"
 	"github.com/kreyyser/transshipment/common/config"
+	"github.com/kreyyser/transshipment/common/locode"
+	"github.com/kreyyser/transshipment/ports/internal/migrations"
+	"github.com/kreyyser/transshipment/ports/internal/services/portentries"
 	r "github.com/oklog/run"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"gorm.io/gorm"
+	"io"
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 )
@@ -19,6 +27,9 @@ type Server struct {
 	ConfigManager *config.ConfigManager
 	GRPCServer    *grpc.Server
 	DB            *gorm.DB
+	PortsDB       portentries.PortsDB
+	Validator     *portentries.DomainValidator
+	closer        io.Closer
 	worker        r.Group
 	lis           net.Listener
 }
@@ -37,7 +48,10 @@ func NewServer(cfmgr *config.ConfigManager) *Server {
 // initialize is responsible of initializing the Server state
 func (pt *Server) initialize() {
 	// TODO a place for adding any middlewares and GRPC tunings
-	pt.GRPCServer = grpc.NewServer()
+	pt.GRPCServer = grpc.NewServer(
+		grpc.UnaryInterceptor(actor.UnaryServerInterceptor),
+		grpc.StreamInterceptor(actor.StreamServerInterceptor),
+	)
 
 	reflection.Register(pt.GRPCServer)
 
@@ -58,15 +72,109 @@ func (pt *Server) initialize() {
 	})
 }
 
-// LoadStores loads datastore
+// LoadStores loads datastore selected by the database option of ports service, postgres by default
 func (pt *Server) LoadStores() error {
 	// A PLACE TO ADD OTHER DATA STORES
+	conf, err := pt.ConfigManager.GetServiceConfig("ports")
+	if err != nil {
+		return err
+	}
+
+	name := conf.Options["database"]
+	if name == "" {
+		name = config.DriverPostgres
+	}
+
+	dbConf, err := pt.ConfigManager.GetDatabaseConfig(name)
+	if err != nil {
+		return err
+	}
+
+	switch dbConf.Driver {
+	case config.DriverPostgres:
+		db, err := pt.openPostgres(name)
+		if err != nil {
+			return err
+		}
+		pt.DB = db
+		pt.PortsDB = portentries.NewDatastore(db)
+	case config.DriverMemory:
+		pt.PortsDB = portentries.NewMemStore()
+	case config.DriverFile:
+		if dbConf.Path == "" {
+			return fmt.Errorf("%s database config misses path", name)
+		}
+		store, err := portentries.OpenFileStore(dbConf.Path)
+		if err != nil {
+			return err
+		}
+		pt.PortsDB = store
+		pt.closer = store
+	default:
+		return fmt.Errorf("unknown database driver %q", dbConf.Driver)
+	}
+
+	return nil
+}
+
+// LoadValidator loads the domain validation of the ports in the mode of the validation option of ports service,
+// either lenient (default) or strict, the unlocks are checked against the UN/LOCODE code list csv files
+// of the unlocodes option separated by commas, only their shape is checked when it is empty
+func (pt *Server) LoadValidator() error {
+	conf, err := pt.ConfigManager.GetServiceConfig("ports")
+	if err != nil {
+		return err
+	}
+
+	mode, err := portentries.ParseValidationMode(conf.Options["validation"])
+	if err != nil {
+		return err
+	}
+
+	rules := portentries.DefaultDomainRules
+	if paths := conf.Options["unlocodes"]; paths != "" {
+		codes, err := readUnlocodes(strings.Split(paths, ","))
+		if err != nil {
+			return err
+		}
+		rules = portentries.DomainRules(codes)
+	}
+	pt.Validator = portentries.NewDomainValidator(mode, rules...)
+
+	return nil
+}
+
+// readUnlocodes reads the codes of the code list split into the files, like the parts of the UNECE release
+func readUnlocodes(paths []string) (locode.Codes, error) {
+	codes := locode.Codes{}
+	for _, path := range paths {
+		f, err := os.Open(strings.TrimSpace(path))
+		if err != nil {
+			return nil, err
+		}
+
+		part, err := locode.ReadCodes(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("invalid unlocodes file %s: %s", path, err)
+		}
+
+		for code := range part {
+			codes[code] = struct{}{}
+		}
+	}
+
+	return codes, nil
+}
+
+// openPostgres connects to postgres giving it a few attempts to come up
+func (pt *Server) openPostgres(name string) (*gorm.DB, error) {
 	var db *gorm.DB
 	for i := 0; i < 3; i++ {
 		var dberr error
-		db, dberr = pt.ConfigManager.OpenDB()
-		if dberr != nil && i == 2{
-			return fmt.Errorf("failed to create postgresql connection: %s", dberr)
+		db, dberr = pt.ConfigManager.OpenDB(name)
+		if dberr != nil && i == 2 {
+			return nil, fmt.Errorf("failed to create postgresql connection: %s", dberr)
 		}
 		if dberr == nil {
 			break
@@ -76,7 +184,61 @@ func (pt *Server) LoadStores() error {
 		time.Sleep(time.Second * 2)
 	}
 
-	pt.DB = db
+	return db, nil
+}
+
+// Migrator returns migrator of the ports db schema, only postgres has one
+func (pt *Server) Migrator() (*migrations.Migrator, error) {
+	if pt.DB == nil {
+		return nil, errors.New("database driver has no schema to migrate")
+	}
+
+	db, err := pt.DB.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	return migrations.New(db)
+}
+
+// CheckSchema applies pending migrations when migrate_on_start option is set,
+// with require_current_schema option set it refuses to go on while there are pending migrations,
+// memory and file databases have no schema so it has nothing to check
+func (pt *Server) CheckSchema() error {
+	if pt.DB == nil {
+		return nil
+	}
+
+	conf, err := pt.ConfigManager.GetServiceConfig("ports")
+	if err != nil {
+		return err
+	}
+
+	migrator, err := pt.Migrator()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if conf.Options["migrate_on_start"] == "true" {
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied migration %04d_%s\n", m.Version, m.Name)
+		}
+
+		return err
+	}
+
+	if conf.Options["require_current_schema"] == "true" {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(pending) > 0 {
+			return fmt.Errorf("schema is behind, %d migrations are pending", len(pending))
+		}
+	}
 
 	return nil
 }
@@ -134,6 +296,18 @@ func (pt *Server) Shutdown() {
 	fmt.Println("shutting down grpc server...")
 	pt.GRPCServer.Stop()
 
+	if pt.closer != nil {
+		fmt.Println("closing datastore")
+		if err := pt.closer.Close(); err != nil {
+			fmt.Println(fmt.Sprintf("failed to close datastore. err: %v", err))
+		}
+		pt.closer = nil
+	}
+
+	if pt.DB == nil {
+		return
+	}
+
 	fmt.Println("shutting down db connections")
 	if db, err := pt.DB.DB(); err != nil {
 		fmt.Println(fmt.Sprintf("failed to close db connection. err: %v", err))
@@ -9,7 +9,7 @@ import (
 
 var initializers = map[string]initializer{
 	"portentries": func(ctx *initCtx) interface{} {
-		return portentries.NewPortsService(ctx.DB)
+		return portentries.NewPortsService(ctx.PortsDB, ctx.Validator)
 	},
 }
 
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	r := require.New(t)
+
+	migrations, err := load()
+	r.NoError(err)
+	r.NotEmpty(migrations)
+
+	for i, m := range migrations {
+		r.Equal(int64(i+1), m.Version, "migration versions should be sequential")
+		r.NotEmpty(m.Up)
+		r.NotEmpty(m.Down)
+	}
+}
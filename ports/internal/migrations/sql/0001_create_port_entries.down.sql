@@ -0,0 +1,3 @@
+DROP TABLE ports.port_entries;
+
+DROP SCHEMA ports;
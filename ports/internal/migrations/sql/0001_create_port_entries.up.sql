@@ -0,0 +1,18 @@
+-- IF NOT EXISTS lets databases created by the former docker entrypoint init script adopt the migrations
+CREATE SCHEMA IF NOT EXISTS ports;
+
+CREATE TABLE IF NOT EXISTS ports.port_entries (
+    id          bigint generated always as identity constraint port_entry_pkey primary key,
+    slug        varchar(100) unique not null,
+    code        varchar(100),
+    name        varchar(200),
+    city        varchar(200),
+    province    varchar(200),
+    country     varchar(200),
+    alias       varchar(200)[],
+    regions     varchar(200)[],
+    latitude    numeric,
+    longitude   numeric,
+    timezone    varchar(200),
+    unlocks     varchar(100)[]
+);
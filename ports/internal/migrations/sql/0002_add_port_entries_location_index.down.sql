@@ -0,0 +1 @@
+DROP INDEX ports.port_entries_location_idx;
@@ -0,0 +1 @@
+CREATE INDEX port_entries_location_idx ON ports.port_entries (latitude, longitude);
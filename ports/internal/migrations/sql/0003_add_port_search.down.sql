@@ -0,0 +1,7 @@
+DROP INDEX ports.port_entries_search_idx;
+
+DROP FUNCTION ports.port_search_document(text, text, varchar[], text, varchar[]);
+
+DROP FUNCTION ports.normalize_text(text);
+
+-- pg_trgm and unaccent extensions are left in place as other schemas may rely on them
@@ -0,0 +1,15 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- normalize_text lowers the text and strips diacritics, unaccent itself is not immutable so it can't be indexed directly
+CREATE FUNCTION ports.normalize_text(value text) RETURNS text AS $$
+    SELECT lower(public.unaccent('public.unaccent', value))
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+-- port_search_document joins every searchable port field into a single normalized text
+CREATE FUNCTION ports.port_search_document(name text, city text, alias varchar[], code text, unlocks varchar[]) RETURNS text AS $$
+    SELECT ports.normalize_text(concat_ws(' ', name, city, array_to_string(alias, ' '), code, array_to_string(unlocks, ' ')))
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+CREATE INDEX port_entries_search_idx ON ports.port_entries
+    USING gin (ports.port_search_document(name, city, alias, code, unlocks) gin_trgm_ops);
@@ -0,0 +1 @@
+DROP TABLE ports.port_entry_history;
@@ -0,0 +1,21 @@
+CREATE TABLE ports.port_entry_history (
+    id          bigint generated always as identity constraint port_entry_history_pkey primary key,
+    port_id     bigint not null,
+    slug        varchar(100) not null,
+    version     bigint not null,
+    operation   varchar(10) not null,
+    old_value   jsonb,
+    new_value   jsonb,
+    actor       varchar(200) not null default '',
+    changed_at  timestamptz not null default now(),
+    constraint port_entry_history_version_key unique (port_id, version)
+);
+
+CREATE INDEX port_entry_history_slug_idx ON ports.port_entry_history (slug, changed_at);
+
+CREATE INDEX port_entry_history_changed_at_idx ON ports.port_entry_history (changed_at);
+
+-- ports existing before the history was introduced get their initial state recorded
+INSERT INTO ports.port_entry_history (port_id, slug, version, operation, new_value, actor)
+SELECT id, slug, 1, 'create', to_jsonb(p), 'migration'
+FROM ports.port_entries p;
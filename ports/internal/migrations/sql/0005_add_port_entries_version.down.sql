@@ -0,0 +1 @@
+ALTER TABLE ports.port_entries DROP COLUMN version;
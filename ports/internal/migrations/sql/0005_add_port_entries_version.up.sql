@@ -0,0 +1 @@
+ALTER TABLE ports.port_entries ADD COLUMN version bigint not null default 1;
@@ -0,0 +1,3 @@
+DROP TABLE ports.port_import_rows;
+
+DROP TABLE ports.port_imports;
@@ -0,0 +1,13 @@
+CREATE TABLE ports.port_imports (
+    id          varchar(64) constraint port_imports_pkey primary key,
+    started_at  timestamptz not null default now()
+);
+
+-- ports staged by the import, they are merged into port_entries at once when the import finishes
+CREATE TABLE ports.port_import_rows (
+    import_id   varchar(64) not null constraint port_import_rows_import_id_fkey
+                    references ports.port_imports (id) on delete cascade,
+    slug        varchar(100) not null,
+    data        jsonb not null,
+    constraint port_import_rows_pkey primary key (import_id, slug)
+);
@@ -0,0 +1,21 @@
+CREATE TABLE ports.import_jobs (
+    id          bigint generated always as identity constraint import_jobs_pkey primary key,
+    status      varchar(20) not null,
+    mode        smallint not null default 0,
+    dry_run     boolean not null default false,
+    file_name   varchar(255) not null default '',
+    actor       varchar(200) not null default '',
+    import_id   varchar(64) not null default '',
+    processed   bigint not null default 0,
+    failed      bigint not null default 0,
+    errors      text[],
+    created     bigint not null default 0,
+    updated     bigint not null default 0,
+    unchanged   bigint not null default 0,
+    removed     bigint not null default 0,
+    created_at  timestamptz not null default now(),
+    updated_at  timestamptz not null default now(),
+    finished_at timestamptz
+);
+
+CREATE INDEX import_jobs_status_idx ON ports.import_jobs (status, id);
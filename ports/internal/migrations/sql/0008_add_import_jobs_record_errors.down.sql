@@ -0,0 +1 @@
+ALTER TABLE ports.import_jobs DROP COLUMN record_errors;
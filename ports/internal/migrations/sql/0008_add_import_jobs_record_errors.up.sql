@@ -0,0 +1,2 @@
+-- record_errors keep the failed records of the imported file as json array of {key, offset, errors}
+ALTER TABLE ports.import_jobs ADD COLUMN record_errors jsonb;
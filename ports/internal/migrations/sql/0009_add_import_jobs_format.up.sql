@@ -0,0 +1,3 @@
+-- format and slug_property tell how the uploaded file of the job is read
+ALTER TABLE ports.import_jobs ADD COLUMN format varchar(16) NOT NULL DEFAULT '',
+    ADD COLUMN slug_property varchar(64) NOT NULL DEFAULT '';
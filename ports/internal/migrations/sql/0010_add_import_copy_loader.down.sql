@@ -0,0 +1,5 @@
+DROP TABLE ports.port_import_copies;
+
+ALTER TABLE ports.import_jobs DROP COLUMN loader;
+
+ALTER TABLE ports.port_imports DROP COLUMN loader;
@@ -0,0 +1,26 @@
+-- loader tells how the ports of the import are loaded, either '' for INSERT or 'copy' for COPY
+ALTER TABLE ports.port_imports ADD COLUMN loader varchar(16) NOT NULL DEFAULT '';
+
+ALTER TABLE ports.import_jobs ADD COLUMN loader varchar(16) NOT NULL DEFAULT '';
+
+-- ports copied by the import with the copy loader, a slug copied again is taken from the latest row,
+-- the table is unlogged as the rows are dropped once the import finishes anyway
+CREATE UNLOGGED TABLE ports.port_import_copies (
+    import_id   varchar(64) not null constraint port_import_copies_import_id_fkey
+                    references ports.port_imports (id) on delete cascade,
+    seq         bigint generated always as identity,
+    slug        varchar(100) not null,
+    code        varchar(100),
+    name        varchar(200),
+    city        varchar(200),
+    province    varchar(200),
+    country     varchar(200),
+    alias       varchar(200)[],
+    regions     varchar(200)[],
+    latitude    numeric,
+    longitude   numeric,
+    timezone    varchar(200),
+    unlocks     varchar(100)[]
+);
+
+CREATE INDEX port_import_copies_import_id_idx ON ports.port_import_copies (import_id, slug, seq);
@@ -0,0 +1 @@
+ALTER TABLE ports.import_jobs DROP COLUMN conflict_policy, DROP COLUMN conflict_fields;
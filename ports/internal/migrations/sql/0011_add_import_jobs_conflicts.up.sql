@@ -0,0 +1,4 @@
+-- conflict_policy and conflict_fields tell how the job changes the stored ports,
+-- conflict_fields map the port fields to the policies overriding conflict_policy
+ALTER TABLE ports.import_jobs ADD COLUMN conflict_policy varchar(32) NOT NULL DEFAULT '',
+    ADD COLUMN conflict_fields jsonb;
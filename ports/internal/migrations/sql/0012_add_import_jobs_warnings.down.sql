@@ -0,0 +1 @@
+ALTER TABLE ports.import_jobs DROP COLUMN warned, DROP COLUMN record_warnings;
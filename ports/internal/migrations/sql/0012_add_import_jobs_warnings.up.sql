@@ -0,0 +1,4 @@
+-- warned counts the imported records breaking the domain rules in lenient validation mode,
+-- record_warnings keep the first of them as json array of {key, offset, errors} the same as record_errors
+ALTER TABLE ports.import_jobs ADD COLUMN warned bigint NOT NULL DEFAULT 0,
+    ADD COLUMN record_warnings jsonb;
@@ -0,0 +1 @@
+DROP INDEX ports.port_entries_unlocks_idx;
@@ -0,0 +1 @@
+CREATE INDEX port_entries_unlocks_idx ON ports.port_entries USING gin (unlocks);
@@ -0,0 +1,68 @@
+package portentries
+
+import (
+	"context"
+	"github.com/kreyyser/transshipment/common/actor"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+)
+
+// actorClient serves the service over the in-memory grpc connection with the actor interceptors
+// the ports server and the gateway use
+func actorClient(t *testing.T, store PortsDB) pb.PortsServiceClient {
+	r := require.New(t)
+	listener := bufconn.Listen(1 << 20)
+
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(actor.UnaryServerInterceptor),
+		grpc.StreamInterceptor(actor.StreamServerInterceptor),
+	)
+	pb.RegisterPortsServiceServer(server, NewPortsService(store, nil))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithUnaryInterceptor(actor.UnaryClientInterceptor),
+		grpc.WithStreamInterceptor(actor.StreamClientInterceptor),
+	)
+	r.NoError(err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewPortsServiceClient(conn)
+}
+
+func TestActor_ReachesHistory(t *testing.T) {
+	r := require.New(t)
+	store := NewMemStore()
+	client := actorClient(t, store)
+	ctx := actor.NewContext(context.Background(), "importer")
+
+	stream, err := client.StreamUpsertPorts(ctx)
+	r.NoError(err)
+	r.NoError(stream.Send(&pb.StreamUpsertPortsRequest{Data: []*pb.Port{{Slug: "NLRTM", Name: "Rotterdam"}}}))
+	res, err := stream.CloseAndRecv()
+	r.NoError(err)
+	r.Equal(int64(1), res.Created)
+
+	_, err = client.CreateOrUpdatePort(actor.NewContext(context.Background(), "editor"), &pb.CreatePortRequest{
+		Data: &pb.Port{Slug: "NLRTM", Name: "Port of Rotterdam"},
+	})
+	r.NoError(err)
+
+	slug := "NLRTM"
+	changes, err := store.History(context.Background(), nil, &slug)
+	r.NoError(err)
+	r.Len(changes, 2)
+
+	actors := map[int64]string{}
+	for _, change := range changes {
+		actors[change.Version] = change.Actor
+	}
+	r.Equal(map[int64]string{1: "importer", 2: "editor"}, actors)
+}
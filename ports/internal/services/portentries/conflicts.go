@@ -0,0 +1,235 @@
+package portentries
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lib/pq"
+	"strings"
+)
+
+// errInvalidConflictsField is returned for the policy of the field which is not a port field
+var errInvalidConflictsField = errors.New("invalid conflicts field")
+
+// maxConflicts is the number of conflicting ports ConflictError reports
+const maxConflicts = 100
+
+// ConflictPolicy tells what the upsert does with the field of the stored port when it brings a different value
+type ConflictPolicy string
+
+const (
+	// ConflictOverwrite stores the new value
+	ConflictOverwrite ConflictPolicy = ""
+	// ConflictSkipExisting keeps the stored value, the new one is stored only for the new ports
+	ConflictSkipExisting ConflictPolicy = "skip_existing"
+	// ConflictFillEmptyOnly stores the new value only when the stored one is empty
+	ConflictFillEmptyOnly ConflictPolicy = "fill_empty_only"
+	// ConflictFail fails the upsert when the stored value is different, nothing is stored then
+	ConflictFail ConflictPolicy = "fail_on_conflict"
+)
+
+// ConflictOptions is the conflict policy of the upsert, Fields override it for single fields like timezone
+type ConflictOptions struct {
+	Policy ConflictPolicy
+	Fields FieldPolicies `gorm:"type:jsonb"`
+}
+
+// FieldPolicies are the conflict policies of the fields, stored as json in the import job
+type FieldPolicies map[string]ConflictPolicy
+
+// Scan implements sql.Scanner interface
+func (f *FieldPolicies) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*f = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, f)
+	case string:
+		return json.Unmarshal([]byte(v), f)
+	default:
+		return errors.New("unsupported field policies value")
+	}
+}
+
+// Value implements driver.Valuer interface
+func (f FieldPolicies) Value() (driver.Value, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(map[string]ConflictPolicy(f))
+}
+
+// ConflictError is returned when the upsert would change the stored ports in the fields which fail on conflict,
+// nothing is stored then
+type ConflictError struct {
+	// Conflicts are the first maxConflicts conflicting ports
+	Conflicts []PortConflict
+	// Total counts every conflicting port
+	Total int
+}
+
+// PortConflict is the stored port the upsert would change in the fields which fail on conflict
+type PortConflict struct {
+	Slug   string
+	Fields []string
+}
+
+func (e *ConflictError) Error() string {
+	conflicts := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		conflicts = append(conflicts, fmt.Sprintf("%s (%s)", c.Slug, strings.Join(c.Fields, ", ")))
+	}
+
+	if e.Total == 1 {
+		return fmt.Sprintf("port conflicts with the stored one: %s", conflicts[0])
+	}
+
+	return fmt.Sprintf("%d ports conflict with the stored ones: %s", e.Total, strings.Join(conflicts, ", "))
+}
+
+// add records the conflicting port
+func (e *ConflictError) add(slug string, fields []string) {
+	e.Total++
+	if len(e.Conflicts) < maxConflicts {
+		e.Conflicts = append(e.Conflicts, PortConflict{Slug: slug, Fields: fields})
+	}
+}
+
+// err returns the error when there was any conflict
+func (e *ConflictError) err() error {
+	if e.Total == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// portField is the field of the port the conflict policy is set for
+type portField struct {
+	name    string
+	columns []string
+	// emptySQL tells the field of the port aliased %[1]s is empty
+	emptySQL string
+	empty    func(p PortEntry) bool
+	same     func(a, b PortEntry) bool
+	set      func(dst *PortEntry, src PortEntry)
+}
+
+// portFields are the fields of the port the conflict policies are set for, in the order of updatableColumns
+var portFields = []portField{
+	textField("code", func(p *PortEntry) *string { return &p.Code }),
+	textField("name", func(p *PortEntry) *string { return &p.Name }),
+	textField("city", func(p *PortEntry) *string { return &p.City }),
+	textField("province", func(p *PortEntry) *string { return &p.Province }),
+	textField("country", func(p *PortEntry) *string { return &p.Country }),
+	listField("alias", func(p *PortEntry) *pq.StringArray { return &p.Alias }),
+	listField("regions", func(p *PortEntry) *pq.StringArray { return &p.Regions }),
+	{
+		name:     "coordinates",
+		columns:  []string{"latitude", "longitude"},
+		emptySQL: "coalesce(%[1]s.latitude, 0) = 0 AND coalesce(%[1]s.longitude, 0) = 0",
+		empty:    func(p PortEntry) bool { return p.Latitude == 0 && p.Longitude == 0 },
+		same: func(a, b PortEntry) bool {
+			return a.Latitude == b.Latitude && a.Longitude == b.Longitude
+		},
+		set: func(dst *PortEntry, src PortEntry) {
+			dst.Latitude, dst.Longitude = src.Latitude, src.Longitude
+		},
+	},
+	textField("timezone", func(p *PortEntry) *string { return &p.Timezone }),
+	listField("unlocks", func(p *PortEntry) *pq.StringArray { return &p.Unlocks }),
+}
+
+func textField(name string, value func(p *PortEntry) *string) portField {
+	return portField{
+		name:     name,
+		columns:  []string{name},
+		emptySQL: fmt.Sprintf("coalesce(%%[1]s.%s, '') = ''", name),
+		empty:    func(p PortEntry) bool { return *value(&p) == "" },
+		same:     func(a, b PortEntry) bool { return *value(&a) == *value(&b) },
+		set:      func(dst *PortEntry, src PortEntry) { *value(dst) = *value(&src) },
+	}
+}
+
+func listField(name string, value func(p *PortEntry) *pq.StringArray) portField {
+	return portField{
+		name:     name,
+		columns:  []string{name},
+		emptySQL: fmt.Sprintf("coalesce(cardinality(%%[1]s.%s), 0) = 0", name),
+		empty:    func(p PortEntry) bool { return len(*value(&p)) == 0 },
+		same:     func(a, b PortEntry) bool { return sameStrings(*value(&a), *value(&b)) },
+		set:      func(dst *PortEntry, src PortEntry) { *value(dst) = *value(&src) },
+	}
+}
+
+// validate checks the policies are set for the port fields
+func (o ConflictOptions) validate() error {
+	names := make([]string, 0, len(portFields))
+	for _, f := range portFields {
+		names = append(names, f.name)
+	}
+
+	for field := range o.Fields {
+		if !containsString(names, field) {
+			return fmt.Errorf("%w %q: value must be one of %s", errInvalidConflictsField, field, strings.Join(names, ", "))
+		}
+	}
+
+	return nil
+}
+
+// policy is the conflict policy of the field
+func (o ConflictOptions) policy(field string) ConflictPolicy {
+	if p, ok := o.Fields[field]; ok {
+		return p
+	}
+
+	return o.Policy
+}
+
+// resolve merges the upserted port into the stored one field by field according to the policies,
+// it returns the fields which fail on conflict and would change
+func (o ConflictOptions) resolve(stored, upserted PortEntry) (PortEntry, []string) {
+	merged := upserted
+	var conflicts []string
+
+	for _, f := range portFields {
+		switch o.policy(f.name) {
+		case ConflictSkipExisting:
+			f.set(&merged, stored)
+		case ConflictFillEmptyOnly:
+			if !f.empty(stored) {
+				f.set(&merged, stored)
+			}
+		case ConflictFail:
+			if !f.same(stored, upserted) {
+				conflicts = append(conflicts, f.name)
+			}
+		}
+	}
+
+	return merged, conflicts
+}
+
+// resolveUpserts merges every upserted port which is stored already according to the policies,
+// ports which fail on conflict are reported by the error
+func (o ConflictOptions) resolveUpserts(before map[string]PortEntry, ports []PortEntry) error {
+	conflicts := &ConflictError{}
+	for i, port := range ports {
+		old, ok := before[port.Slug]
+		if !ok {
+			continue
+		}
+
+		merged, fields := o.resolve(old, port)
+		if len(fields) > 0 {
+			conflicts.add(port.Slug, fields)
+		}
+		ports[i] = merged
+	}
+
+	return conflicts.err()
+}
@@ -0,0 +1,266 @@
+package portentries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/kreyyser/transshipment/common/actor"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+// copyColumns are the columns of the copied ports in the COPY order
+var copyColumns = append([]string{"import_id", "slug"}, updatableColumns...)
+
+var (
+	// copiedTableSQL creates the temporary table the merge statements read, it is dropped with the transaction
+	copiedTableSQL = fmt.Sprintf(`CREATE TEMP TABLE import_copied ON COMMIT DROP AS
+SELECT slug, %s FROM ports.port_import_copies WITH NO DATA`, strings.Join(updatableColumns, ", "))
+
+	// copiedSQL collects the latest copy of every slug of the import
+	copiedSQL = fmt.Sprintf(`INSERT INTO import_copied
+SELECT DISTINCT ON (slug) slug, %s
+FROM ports.port_import_copies
+WHERE import_id = ?
+ORDER BY slug, seq DESC`, strings.Join(updatableColumns, ", "))
+
+	// copiedRemovedSQL counts the ports the sync deletes
+	copiedRemovedSQL = `SELECT count(*)
+FROM ports.port_entries p
+WHERE NOT EXISTS (SELECT 1 FROM import_copied c WHERE c.slug = p.slug)`
+
+	// copiedInsertSQL creates the new ports and records their history
+	copiedInsertSQL = fmt.Sprintf(`WITH created AS (
+	INSERT INTO ports.port_entries (slug, %[1]s)
+	SELECT c.slug, c.%[2]s
+	FROM import_copied c
+	WHERE NOT EXISTS (SELECT 1 FROM ports.port_entries p WHERE p.slug = c.slug)
+	RETURNING *
+)
+INSERT INTO ports.port_entry_history (port_id, slug, version, operation, new_value, actor, changed_at)
+SELECT n.id, n.slug, n.version, ?, to_jsonb(n), ?, ? FROM created n`,
+		strings.Join(updatableColumns, ", "), strings.Join(updatableColumns, ", c."))
+
+	// copiedDeleteSQL deletes the ports missing from the import and records their history
+	copiedDeleteSQL = `WITH removed AS (
+	DELETE FROM ports.port_entries p
+	WHERE NOT EXISTS (SELECT 1 FROM import_copied c WHERE c.slug = p.slug)
+	RETURNING *
+)
+INSERT INTO ports.port_entry_history (port_id, slug, version, operation, old_value, actor, changed_at)
+SELECT r.id, r.slug, r.version + 1, ?, to_jsonb(r), ?, ? FROM removed r`
+)
+
+// copiedMerge are the statements merging the copies into the stored ports according to the conflict policies
+type copiedMerge struct {
+	// changedSQL tells the stored port p differs from its merge with the copy c
+	changedSQL string
+	// conflictsSQL lists the stored ports the copies would change in the fields which fail on conflict
+	conflictsSQL string
+	// summarySQL counts the ports the merge creates, updates and leaves unchanged
+	summarySQL string
+	// updateSQL updates the changed ports and records their history,
+	// o is the port as it was before the update, so the history gets the old value
+	updateSQL string
+}
+
+// newCopiedMerge builds the merge statements, every column of the stored port p is set to the value
+// its conflict policy takes from p or the copy c
+func newCopiedMerge(conflicts ConflictOptions) copiedMerge {
+	var stored, merged, set, conflicting, failing []string
+
+	for _, f := range portFields {
+		policy := conflicts.policy(f.name)
+		for _, column := range f.columns {
+			value := "c." + column
+			switch policy {
+			case ConflictSkipExisting:
+				value = "p." + column
+			case ConflictFillEmptyOnly:
+				value = fmt.Sprintf("CASE WHEN %s THEN c.%s ELSE p.%s END", fmt.Sprintf(f.emptySQL, "p"), column, column)
+			}
+
+			stored = append(stored, "p."+column)
+			merged = append(merged, value)
+			set = append(set, fmt.Sprintf("%s = %s", column, value))
+		}
+
+		if policy == ConflictFail {
+			distinct := fmt.Sprintf("(p.%s) IS DISTINCT FROM (c.%s)",
+				strings.Join(f.columns, ", p."), strings.Join(f.columns, ", c."))
+			conflicting = append(conflicting, fmt.Sprintf("CASE WHEN %s THEN '%s' END", distinct, f.name))
+			failing = append(failing, distinct)
+		}
+	}
+
+	m := copiedMerge{
+		changedSQL: fmt.Sprintf("(%s) IS DISTINCT FROM (%s)", strings.Join(stored, ", "), strings.Join(merged, ", ")),
+	}
+
+	if len(failing) > 0 {
+		m.conflictsSQL = fmt.Sprintf(`SELECT c.slug, array_remove(ARRAY[%s], NULL) AS fields, count(*) OVER () AS total
+FROM import_copied c
+JOIN ports.port_entries p ON p.slug = c.slug
+WHERE %s
+ORDER BY c.slug
+LIMIT %d`, strings.Join(conflicting, ", "), strings.Join(failing, " OR "), maxConflicts)
+	}
+
+	m.summarySQL = fmt.Sprintf(`SELECT
+	count(*) FILTER (WHERE p.id IS NULL) AS created,
+	count(*) FILTER (WHERE p.id IS NOT NULL AND %[1]s) AS updated,
+	count(*) FILTER (WHERE p.id IS NOT NULL AND NOT %[1]s) AS unchanged
+FROM import_copied c
+LEFT JOIN ports.port_entries p ON p.slug = c.slug`, m.changedSQL)
+
+	// the WHERE clause and the SET list see the port as it was before the update
+	m.updateSQL = fmt.Sprintf(`WITH changed AS (
+	UPDATE ports.port_entries p
+	SET %s, version = p.version + 1
+	FROM import_copied c, ports.port_entries o
+	WHERE p.slug = c.slug AND o.id = p.id AND %s
+	RETURNING p.id, p.slug, p.version, to_jsonb(o) AS old_value, to_jsonb(p) AS new_value
+)
+INSERT INTO ports.port_entry_history (port_id, slug, version, operation, old_value, new_value, actor, changed_at)
+SELECT id, slug, version, ?, old_value, new_value, ?, ? FROM changed`,
+		strings.Join(set, ", "), m.changedSQL)
+
+	return m
+}
+
+// copiedConflict is the stored port the copy would change in the fields which fail on conflict
+type copiedConflict struct {
+	Slug   string
+	Fields pq.StringArray `gorm:"type:text[]"`
+	Total  int
+}
+
+// copyImport streams the ports into the copies of the import with COPY,
+// the import is locked meanwhile, so it is not finished with half of the ports copied
+func (d Datastore) copyImport(ctx context.Context, importID string, ports []PortEntry) error {
+	sqlDB, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	// COPY is not available through database/sql, it goes through the pgx connection underneath
+	return conn.Raw(func(driverConn interface{}) error {
+		pgxConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("copy loader needs the pgx driver")
+		}
+
+		tx, err := pgxConn.Conn().Begin(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback(ctx) }()
+
+		var locked int
+		err = tx.QueryRow(ctx, "SELECT 1 FROM ports.port_imports WHERE id = $1 FOR UPDATE", importID).Scan(&locked)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrImportNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		rows := make([][]interface{}, 0, len(ports))
+		for _, p := range ports {
+			rows = append(rows, []interface{}{
+				importID, p.Slug, p.Code, p.Name, p.City, p.Province, p.Country, []string(p.Alias), []string(p.Regions),
+				p.Latitude, p.Longitude, p.Timezone, []string(p.Unlocks),
+			})
+		}
+
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"ports", "port_import_copies"}, copyColumns, pgx.CopyFromRows(rows))
+		if err != nil {
+			return err
+		}
+
+		return tx.Commit(ctx)
+	})
+}
+
+// mergeCopied merges the copied ports into port_entries with a few set-based statements according to the conflict policies,
+// sync mode deletes the ports which were not copied, the caller locks port_entries against other writes
+func mergeCopied(ctx context.Context, tx *gorm.DB, importID string, opts ImportOptions) (ImportSummary, error) {
+	var summary ImportSummary
+	merge := newCopiedMerge(opts.Conflicts)
+
+	if err := tx.Exec(copiedTableSQL).Error; err != nil {
+		return summary, err
+	}
+
+	if err := tx.Exec(copiedSQL, importID).Error; err != nil {
+		return summary, err
+	}
+
+	if err := tx.Exec("ALTER TABLE import_copied ADD PRIMARY KEY (slug)").Error; err != nil {
+		return summary, err
+	}
+
+	if err := tx.Exec("ANALYZE import_copied").Error; err != nil {
+		return summary, err
+	}
+
+	if merge.conflictsSQL != "" {
+		var found []copiedConflict
+		if err := tx.Raw(merge.conflictsSQL).Scan(&found).Error; err != nil {
+			return summary, err
+		}
+
+		if len(found) > 0 {
+			conflicts := &ConflictError{Total: found[0].Total}
+			for _, c := range found {
+				conflicts.Conflicts = append(conflicts.Conflicts, PortConflict{Slug: c.Slug, Fields: c.Fields})
+			}
+
+			return summary, conflicts
+		}
+	}
+
+	if err := tx.Raw(merge.summarySQL).Scan(&summary).Error; err != nil {
+		return summary, err
+	}
+
+	if opts.Mode == ImportSync {
+		if err := tx.Raw(copiedRemovedSQL).Scan(&summary.Removed).Error; err != nil {
+			return summary, err
+		}
+	}
+
+	if opts.DryRun {
+		return summary, nil
+	}
+
+	// the history rows are written the way saveChanges writes them
+	now := time.Now().UTC()
+	who := actor.FromContext(ctx)
+
+	if err := tx.Exec(merge.updateSQL, OperationUpdate, who, now).Error; err != nil {
+		return summary, err
+	}
+
+	if err := tx.Exec(copiedInsertSQL, OperationCreate, who, now).Error; err != nil {
+		return summary, err
+	}
+
+	if opts.Mode == ImportSync {
+		if err := tx.Exec(copiedDeleteSQL, OperationDelete, who, now).Error; err != nil {
+			return summary, err
+		}
+	}
+
+	return summary, nil
+}
@@ -3,23 +3,86 @@ package portentries
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/jackc/pgconn"
 	"github.com/lib/pq"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"math"
+	"strings"
+	"time"
 )
 
 var updatableColumns = []string{
 	"code", "name", "city", "province", "country", "alias", "regions", "latitude", "longitude", "timezone", "unlocks",
 }
 
+var (
+	// ErrVersionConflict is returned when the port was changed since the caller has read it
+	ErrVersionConflict = errors.New("port version conflict")
+	// ErrPortNotFound is returned when there is no port with the given id or slug
+	ErrPortNotFound = errors.New("port not found")
+	// ErrPortExists is returned when the created port has the slug of the stored one
+	ErrPortExists = errors.New("port with this slug already exists")
+	// ErrMissingPortKey is returned when the port is given by neither id nor slug
+	ErrMissingPortKey = errors.New("port id or slug is required")
+
+	// versionBumpSQL increments the port version on upsert only when any of updatable columns changes
+	versionBumpSQL = fmt.Sprintf(
+		"CASE WHEN (port_entries.%s) IS DISTINCT FROM (EXCLUDED.%s) THEN port_entries.version + 1 ELSE port_entries.version END",
+		strings.Join(updatableColumns, ", port_entries."),
+		strings.Join(updatableColumns, ", EXCLUDED."),
+	)
+)
+
+// uniqueViolation is the postgres error code of the unique constraint violation
+const uniqueViolation = "23505"
+
+// sortableColumns maps ListQuery.OrderBy values to the db columns ports can be sorted by
+var sortableColumns = map[string]string{
+	"id":      "id",
+	"slug":    "slug",
+	"name":    "coalesce(name, '')",
+	"city":    "coalesce(city, '')",
+	"country": "coalesce(country, '')",
+}
+
 type PortsDB interface {
-	List(ctx context.Context) ([]PortEntry, error)
-	Upsert(ctx context.Context, port PortEntry) (PortEntry, error)
-	BulkUpsert(ctx context.Context, ports []PortEntry) ([]PortEntry, error)
+	List(ctx context.Context, query ListQuery) ([]PortEntry, error)
+	Upsert(ctx context.Context, port PortEntry, conflicts ConflictOptions) (PortEntry, error)
+	BulkUpsert(ctx context.Context, ports []PortEntry, conflicts ConflictOptions) ([]UpsertResult, error)
 	Fetch(ctx context.Context, id *int64, slug *string) (PortEntry, error)
+	BatchFetch(ctx context.Context, keys PortKeys) ([]PortEntry, error)
 	Store(ctx context.Context, port *PortEntry) error
 	Update(ctx context.Context, port *PortEntry) error
-	Delete(ctx context.Context, id *int64, slug *string) error
+	Delete(ctx context.Context, id *int64, slug *string, version *int64) error
+	Nearest(ctx context.Context, query NearestQuery) ([]PortDistance, error)
+	Search(ctx context.Context, query SearchQuery) ([]PortMatch, error)
+	FetchAsOf(ctx context.Context, id *int64, slug *string, asOf time.Time) (PortEntry, error)
+	History(ctx context.Context, id *int64, slug *string) ([]PortChange, error)
+	StartImport(ctx context.Context, loader ImportLoader) (string, error)
+	StageImport(ctx context.Context, importID string, ports []PortEntry) error
+	FinishImport(ctx context.Context, importID string, opts ImportOptions) (ImportSummary, error)
+	AbortImport(ctx context.Context, importID string) error
+	CreateImportJob(ctx context.Context, job *ImportJob) error
+	UpdateImportJob(ctx context.Context, update ImportJobUpdate) (ImportJob, error)
+	FetchImportJob(ctx context.Context, id int64) (ImportJob, error)
+	ListImportJobs(ctx context.Context, query ImportJobsQuery) ([]ImportJob, error)
+}
+
+// UpsertStatus tells what the upsert has done with the port
+type UpsertStatus int
+
+const (
+	UpsertCreated UpsertStatus = iota + 1
+	UpsertUpdated
+	UpsertUnchanged
+)
+
+// UpsertResult is the port stored by the upsert with what has been done with it
+type UpsertResult struct {
+	PortEntry
+	Status UpsertStatus
 }
 
 // Datastore is the port entries data layer access object
@@ -33,20 +96,22 @@ func NewDatastore(db *gorm.DB) PortsDB {
 }
 
 // PortEntry represents internal model for ports manipulation
+// json tags follow the db column names, so the history snapshots can be turned back into rows
 type PortEntry struct {
-	ID        int64  `gorm:"primaryKey"`
-	Slug      string `gorm:"type:varchar(10)"`
-	Code      string `gorm:"type:varchar(10)"`
-	Name      string
-	City      string
-	Province  string
-	Country   string
-	Alias     pq.StringArray `gorm:"type:varchar(200)[]"`
-	Regions   pq.StringArray `gorm:"type:varchar(200)[]"`
-	Latitude  float64
-	Longitude float64
-	Timezone  string
-	Unlocks   pq.StringArray `gorm:"type:varchar(10)[]"`
+	ID        int64          `gorm:"primaryKey" json:"id"`
+	Slug      string         `gorm:"type:varchar(100)" json:"slug"`
+	Code      string         `gorm:"type:varchar(100)" json:"code"`
+	Name      string         `json:"name"`
+	City      string         `json:"city"`
+	Province  string         `json:"province"`
+	Country   string         `json:"country"`
+	Alias     pq.StringArray `gorm:"type:varchar(200)[]" json:"alias"`
+	Regions   pq.StringArray `gorm:"type:varchar(200)[]" json:"regions"`
+	Latitude  float64        `json:"latitude"`
+	Longitude float64        `json:"longitude"`
+	Timezone  string         `json:"timezone"`
+	Unlocks   pq.StringArray `gorm:"type:varchar(100)[]" json:"unlocks"`
+	Version   int64          `gorm:"default:1" json:"version"`
 }
 
 // TableName sets proper table name for db queries with GORM ORM
@@ -54,40 +119,142 @@ func (PortEntry) TableName() string {
 	return "ports.port_entries"
 }
 
+// ListQuery describes filtering, sorting and keyset pagination of the ports list
+type ListQuery struct {
+	Limit      int
+	After      *Cursor
+	Country    string
+	Province   string
+	Region     string
+	Timezone   string
+	CodePrefix string
+	OrderBy    string
+	Descending bool
+	// AsOf lists the ports as they were at the given time
+	AsOf *time.Time
+}
+
+// PortKeys are the ids, slugs and unlocks BatchFetch finds the ports by
+type PortKeys struct {
+	IDs     []int64
+	Slugs   []string
+	Unlocks []string
+}
+
+// Cursor points to the last port of the previous page
+type Cursor struct {
+	Value string
+	ID    int64
+}
+
 // List fetches ports list from the db
-func (d Datastore) List(ctx context.Context) ([]PortEntry, error) {
+func (d Datastore) List(ctx context.Context, query ListQuery) ([]PortEntry, error) {
+	column, ok := sortableColumns[query.OrderBy]
+	if !ok {
+		column = sortableColumns["id"]
+	}
+
+	direction, cmp := "ASC", ">"
+	if query.Descending {
+		direction, cmp = "DESC", "<"
+	}
+
+	q := d.db.WithContext(ctx).Model(&PortEntry{})
+	if query.AsOf != nil {
+		q = q.Table(asOfTableSQL, *query.AsOf)
+	}
+
+	if query.Country != "" {
+		q = q.Where("lower(country) = lower(?)", query.Country)
+	}
+	if query.Province != "" {
+		q = q.Where("lower(province) = lower(?)", query.Province)
+	}
+	if query.Region != "" {
+		q = q.Where("? = ANY(regions)", query.Region)
+	}
+	if query.Timezone != "" {
+		q = q.Where("timezone = ?", query.Timezone)
+	}
+	if query.CodePrefix != "" {
+		q = q.Where("code LIKE ?", escapeLike(query.CodePrefix)+"%")
+	}
+
+	if query.After != nil {
+		if column == "id" {
+			q = q.Where(fmt.Sprintf("id %s ?", cmp), query.After.ID)
+		} else {
+			q = q.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, cmp), query.After.Value, query.After.ID)
+		}
+	}
+
+	if column != "id" {
+		q = q.Order(fmt.Sprintf("%s %s", column, direction))
+	}
+	q = q.Order(fmt.Sprintf("id %s", direction))
+
+	if query.Limit > 0 {
+		q = q.Limit(query.Limit)
+	}
+
 	var ports []PortEntry
-	if res := d.db.WithContext(ctx).Model(&PortEntry{}).Find(&ports); res.Error != nil {
+	if res := q.Find(&ports); res.Error != nil {
 		return nil, res.Error
 	}
 
 	return ports, nil
 }
 
-// Upsert creates or updates port in the db
-func (d Datastore) Upsert(ctx context.Context, port PortEntry) (PortEntry, error) {
-	res := d.db.WithContext(ctx).Model(&PortEntry{}).Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "slug"}},
-		DoUpdates: clause.AssignmentColumns(updatableColumns),
-	}).Create(&port)
-	if res.Error != nil {
-		return PortEntry{}, res.Error
+// Upsert creates or updates port in the db, the stored port is changed according to the conflict policies
+func (d Datastore) Upsert(ctx context.Context, port PortEntry, conflicts ConflictOptions) (PortEntry, error) {
+	results, err := d.BulkUpsert(ctx, []PortEntry{port}, conflicts)
+	if err != nil {
+		return PortEntry{}, err
 	}
 
-	return port, nil
+	return results[0].PortEntry, nil
 }
 
-// BulkUpsert creates or updates ports in the db
-func (d Datastore) BulkUpsert(ctx context.Context, ports []PortEntry) ([]PortEntry, error) {
-	res := d.db.WithContext(ctx).Model(&PortEntry{}).Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "slug"}},
-		DoUpdates: clause.AssignmentColumns(updatableColumns),
-	}).Create(&ports)
-	if res.Error != nil {
-		return []PortEntry{}, res.Error
+// BulkUpsert creates or updates ports in the db, results follow the order of the ports,
+// the stored ports are changed according to the conflict policies and *ConflictError fails the whole batch
+func (d Datastore) BulkUpsert(ctx context.Context, ports []PortEntry, conflicts ConflictOptions) ([]UpsertResult, error) {
+	ports = append([]PortEntry(nil), ports...)
+
+	var results []UpsertResult
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		slugs := make([]string, 0, len(ports))
+		for _, p := range ports {
+			slugs = append(slugs, p.Slug)
+		}
+
+		var existing []PortEntry
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("slug IN ?", slugs).Find(&existing).Error; err != nil {
+			return err
+		}
+
+		before := bySlug(existing)
+		if err := conflicts.resolveUpserts(before, ports); err != nil {
+			return err
+		}
+
+		res := tx.Model(&PortEntry{}).Clauses(upsertClause()).Create(&ports)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if err := reloadUpserted(tx, ports); err != nil {
+			return err
+		}
+
+		results = upsertResults(before, ports)
+
+		return recordChanges(ctx, tx, before, ports)
+	})
+	if err != nil {
+		return []UpsertResult{}, err
 	}
 
-	return ports, nil
+	return results, nil
 }
 
 // Fetch fetches single port by id or slug
@@ -102,33 +269,285 @@ func (d Datastore) Fetch(ctx context.Context, id *int64, slug *string) (PortEntr
 	}
 
 	res := q.Find(&port)
+	if res.Error != nil {
+		return PortEntry{}, res.Error
+	}
+
 	if res.RowsAffected == 0 {
-		return PortEntry{}, errors.New("port not found")
+		return PortEntry{}, ErrPortNotFound
+	}
+
+	return port, nil
+}
+
+// BatchFetch fetches the ports matching any of the keys from the DB, ordered by id
+func (d Datastore) BatchFetch(ctx context.Context, keys PortKeys) ([]PortEntry, error) {
+	q := d.db.WithContext(ctx).Where("false")
+	if len(keys.IDs) > 0 {
+		q = q.Or("id IN ?", keys.IDs)
+	}
+	if len(keys.Slugs) > 0 {
+		q = q.Or("slug IN ?", keys.Slugs)
+	}
+	if len(keys.Unlocks) > 0 {
+		q = q.Or("unlocks && ?::varchar[]", pq.StringArray(keys.Unlocks))
+	}
+
+	var ports []PortEntry
+	if err := q.Order("id").Find(&ports).Error; err != nil {
+		return nil, err
 	}
 
-	return port, res.Error
+	return ports, nil
 }
 
 // Store creates new port in the DB
 func (d Datastore) Store(ctx context.Context, port *PortEntry) error {
-	return d.db.WithContext(ctx).Create(port).Error
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(port).Error; err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return ErrPortExists
+			}
+			return err
+		}
+
+		return recordChanges(ctx, tx, nil, []PortEntry{*port})
+	})
 }
 
 // Update updates new port in the DB
+// port version has to match the stored one, otherwise ErrVersionConflict is returned
 func (d Datastore) Update(ctx context.Context, port *PortEntry) error {
-	return d.db.WithContext(ctx).Save(port).Error
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var old PortEntry
+		res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", port.ID).Find(&old)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return ErrPortNotFound
+		}
+
+		if old.Version != port.Version {
+			return ErrVersionConflict
+		}
+
+		if samePort(old, *port) {
+			return nil
+		}
+
+		port.Version++
+		if err := tx.Save(port).Error; err != nil {
+			return err
+		}
+
+		return recordChanges(ctx, tx, bySlug([]PortEntry{old}), []PortEntry{*port})
+	})
 }
 
-// Delete updates new port in the DB
-func (d Datastore) Delete(ctx context.Context, id *int64, slug *string) error {
-	q := d.db.WithContext(ctx)
+// Delete deletes the port given by id or slug from the DB, ErrMissingPortKey is returned when both are nil
+// and ErrPortNotFound when no port matches them,
+// when version is given it has to match the stored one, otherwise ErrVersionConflict is returned
+func (d Datastore) Delete(ctx context.Context, id *int64, slug *string, version *int64) error {
+	if id == nil && slug == nil {
+		return ErrMissingPortKey
+	}
 
-	if id != nil {
-		q = q.Where("id = ?", *id)
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := tx
+
+		if id != nil {
+			q = q.Where("id = ?", *id)
+		}
+		if slug != nil {
+			q = q.Where("slug = ?", *slug)
+		}
+
+		var deleted []PortEntry
+		if err := q.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&deleted).Error; err != nil {
+			return err
+		}
+
+		if len(deleted) == 0 {
+			return ErrPortNotFound
+		}
+
+		ids := make([]int64, 0, len(deleted))
+		for _, p := range deleted {
+			if version != nil && p.Version != *version {
+				return ErrVersionConflict
+			}
+			ids = append(ids, p.ID)
+		}
+
+		if err := tx.Where("id IN ?", ids).Delete(&PortEntry{}).Error; err != nil {
+			return err
+		}
+
+		return recordDeletes(ctx, tx, deleted)
+	})
+}
+
+// Nearest fetches ports closest to the location ordered by distance
+// the search area grows until it has enough ports, so only a small part of the location index is scanned
+func (d Datastore) Nearest(ctx context.Context, query NearestQuery) ([]PortDistance, error) {
+	maxRadius := query.RadiusKm
+	if maxRadius <= 0 || maxRadius > maxDistanceKm {
+		maxRadius = maxDistanceKm
 	}
-	if slug != nil {
-		q = q.Where("slug = ?", *slug)
+
+	radius := math.Min(initialSearchRadiusKm, maxRadius)
+	for {
+		ports, err := d.nearestWithin(ctx, query, radius)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ports) >= query.Limit || radius >= maxRadius {
+			return ports, nil
+		}
+
+		radius = math.Min(radius*4, maxRadius)
+	}
+}
+
+func (d Datastore) nearestWithin(ctx context.Context, query NearestQuery, radius float64) ([]PortDistance, error) {
+	box := newBoundingBox(query.Latitude, query.Longitude, radius)
+
+	lngConds := make([]string, 0, len(box.LngRanges))
+	lngArgs := make([]interface{}, 0, len(box.LngRanges)*2)
+	for _, rng := range box.LngRanges {
+		lngConds = append(lngConds, "longitude BETWEEN ? AND ?")
+		lngArgs = append(lngArgs, rng[0], rng[1])
+	}
+
+	q := d.db.WithContext(ctx).Model(&PortEntry{}).
+		Select("*, "+haversineSQL+" AS distance_km", query.Latitude, query.Latitude, query.Longitude).
+		Where("latitude BETWEEN ? AND ?", box.MinLat, box.MaxLat).
+		Where("("+strings.Join(lngConds, " OR ")+")", lngArgs...)
+
+	if query.Country != "" {
+		q = q.Where("lower(country) = lower(?)", query.Country)
+	}
+
+	var ports []PortDistance
+	res := q.Where(haversineSQL+" <= ?", query.Latitude, query.Latitude, query.Longitude, radius).
+		Order("distance_km").
+		Limit(query.Limit).
+		Find(&ports)
+	if res.Error != nil {
+		return nil, res.Error
 	}
 
-	return q.Delete(&PortEntry{}).Error
+	return ports, nil
+}
+
+// Search fetches ports fuzzy matching the text ordered by relevance
+// matching ignores case and diacritics and tolerates typos thanks to trigram similarity
+func (d Datastore) Search(ctx context.Context, query SearchQuery) ([]PortMatch, error) {
+	text := normalizeSearchText(query.Text)
+	if text == "" {
+		return []PortMatch{}, nil
+	}
+	upper := strings.ToUpper(strings.ReplaceAll(text, " ", ""))
+
+	var ports []PortMatch
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		threshold := fmt.Sprintf("SET LOCAL pg_trgm.word_similarity_threshold = %f", searchThreshold)
+		if err := tx.Exec(threshold).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&PortEntry{}).
+			Select("*, "+searchScoreSQL+" AS score",
+				gorm.Expr("ports.normalize_text(?)", text),
+				gorm.Expr("ports.normalize_text(?)", text),
+				gorm.Expr("ports.normalize_text(?)", text),
+				gorm.Expr("ports.normalize_text(?)", text),
+				upper, upper, upper,
+			).
+			Where("ports.normalize_text(?) <% "+searchDocumentSQL, text).
+			Order("score DESC").
+			Order("id").
+			Limit(query.Limit).
+			Find(&ports).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ports, nil
+}
+
+// upsertClause updates existing ports by slug and bumps their version when anything has changed
+func upsertClause() clause.OnConflict {
+	set := clause.AssignmentColumns(updatableColumns)
+	set = append(set, clause.Assignment{
+		Column: clause.Column{Name: "version"},
+		Value:  gorm.Expr(versionBumpSQL),
+	})
+
+	return clause.OnConflict{
+		Columns:   []clause.Column{{Name: "slug"}},
+		DoUpdates: set,
+	}
+}
+
+// reloadUpserted reads the upserted ports back, the version is bumped by the db on conflict
+// so the ports keep the default version otherwise
+func reloadUpserted(tx *gorm.DB, ports []PortEntry) error {
+	slugs := make([]string, 0, len(ports))
+	for _, p := range ports {
+		slugs = append(slugs, p.Slug)
+	}
+
+	var stored []PortEntry
+	if err := tx.Where("slug IN ?", slugs).Find(&stored).Error; err != nil {
+		return err
+	}
+
+	upserted := bySlug(stored)
+	for i, p := range ports {
+		port, ok := upserted[p.Slug]
+		if !ok {
+			return fmt.Errorf("upserted port %s is missing", p.Slug)
+		}
+		ports[i] = port
+	}
+
+	return nil
+}
+
+// upsertResults tells which of the upserted ports were created, updated or left unchanged
+func upsertResults(before map[string]PortEntry, after []PortEntry) []UpsertResult {
+	results := make([]UpsertResult, 0, len(after))
+	for _, port := range after {
+		result := UpsertResult{PortEntry: port, Status: UpsertCreated}
+		if old, ok := before[port.Slug]; ok {
+			result.Status = UpsertUpdated
+			if samePort(old, port) {
+				result.Status = UpsertUnchanged
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// bySlug indexes ports by their slugs
+func bySlug(ports []PortEntry) map[string]PortEntry {
+	res := make(map[string]PortEntry, len(ports))
+	for _, p := range ports {
+		res[p.Slug] = p
+	}
+
+	return res
+}
+
+// escapeLike escapes LIKE pattern wildcards in the user provided value
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
@@ -0,0 +1,226 @@
+package portentries
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"github.com/kreyyser/transshipment/common/locode"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"time"
+	// the time zones are checked against the embedded IANA database, whatever the host has installed
+	_ "time/tzdata"
+	"unicode"
+)
+
+// countriesCSV is the ISO 3166-1 table of the country codes with their english names and aliases separated by |
+//
+//go:embed refdata/countries.csv
+var countriesCSV string
+
+// countries maps the country codes and the normalized names of the countries to their codes
+var countries = loadCountries(countriesCSV)
+
+// ValidationMode tells what happens to the ports breaking the domain rules
+type ValidationMode string
+
+const (
+	// ValidationLenient stores the ports breaking the domain rules and reports the broken rules as warnings
+	ValidationLenient ValidationMode = "lenient"
+	// ValidationStrict rejects the ports breaking the domain rules the same as the invalid ones
+	ValidationStrict ValidationMode = "strict"
+)
+
+// ParseValidationMode reads the validation option of the ports service, the mode is lenient when it is empty
+func ParseValidationMode(name string) (ValidationMode, error) {
+	switch mode := ValidationMode(name); mode {
+	case "":
+		return ValidationLenient, nil
+	case ValidationLenient, ValidationStrict:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown validation mode %q, should be either strict or lenient", name)
+	}
+}
+
+// DomainRule checks the port against the reference data, it returns the errors of the fields breaking the rule
+type DomainRule func(port PortEntry) []FieldError
+
+// DefaultDomainRules check the country, the time zone and the unlocks of the port and the country prefix of its slug
+var DefaultDomainRules = []DomainRule{CountryRule, TimezoneRule, UnlocksRule, SlugCountryRule}
+
+// DomainRules are the default rules checking the unlocks are the locations of the given code list on top of their shape
+func DomainRules(codes locode.Codes) []DomainRule {
+	return []DomainRule{CountryRule, TimezoneRule, NewUnlocksRule(codes), SlugCountryRule}
+}
+
+// DomainValidator checks the ports with the domain rules on top of the validation rules of the api,
+// nil validator checks nothing
+type DomainValidator struct {
+	mode  ValidationMode
+	rules []DomainRule
+}
+
+// NewDomainValidator returns the validator checking the ports with the rules in the given mode
+func NewDomainValidator(mode ValidationMode, rules ...DomainRule) *DomainValidator {
+	return &DomainValidator{mode: mode, rules: rules}
+}
+
+// Check returns the broken rules of the port, as errors in strict mode so the port is rejected
+// and as warnings in lenient mode so the port is stored anyway
+func (v *DomainValidator) Check(port PortEntry) (errs, warnings []FieldError) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var broken []FieldError
+	for _, rule := range v.rules {
+		broken = append(broken, rule(port)...)
+	}
+
+	if v.mode == ValidationStrict {
+		return broken, nil
+	}
+
+	return nil, broken
+}
+
+// CountryRule checks the country is either the ISO 3166 code or the english name of the country
+func CountryRule(port PortEntry) []FieldError {
+	if port.Country == "" {
+		return nil
+	}
+
+	if _, ok := countryCode(port.Country); !ok {
+		return []FieldError{{Field: "country", Reason: "value must be an ISO 3166 country code or name", Rule: "iso3166"}}
+	}
+
+	return nil
+}
+
+// TimezoneRule checks the time zone is the name of the IANA time zone database, like Europe/Amsterdam
+func TimezoneRule(port PortEntry) []FieldError {
+	if port.Timezone == "" {
+		return nil
+	}
+
+	if _, err := time.LoadLocation(port.Timezone); err != nil || port.Timezone == "Local" {
+		return []FieldError{{Field: "timezone", Reason: "value must be an IANA time zone", Rule: "iana_tz"}}
+	}
+
+	return nil
+}
+
+// UnlocksRule checks every unlock has the shape of the UN/LOCODE, like NLRTM, it has no code list to check
+// the location is known, NewUnlocksRule checks that
+func UnlocksRule(port PortEntry) []FieldError {
+	return NewUnlocksRule(nil)(port)
+}
+
+// NewUnlocksRule returns the rule checking every unlock is the UN/LOCODE of a location of the code list,
+// only the shape of the unlocks is checked when the list is nil
+func NewUnlocksRule(codes locode.Codes) DomainRule {
+	return func(port PortEntry) []FieldError {
+		var errs []FieldError
+		for i, unlock := range port.Unlocks {
+			reason := ""
+			switch {
+			case !validLocode(unlock):
+				reason = "value must be a UN/LOCODE of two letters of the country and three of the location"
+			case codes != nil && !codes.Has(unlock):
+				reason = "value must be a location of the UN/LOCODE code list"
+			default:
+				continue
+			}
+
+			errs = append(errs, FieldError{Field: fmt.Sprintf("unlocks[%d]", i), Reason: reason, Rule: "unlocode"})
+		}
+
+		return errs
+	}
+}
+
+// SlugCountryRule checks the slug starts with the code of the port country, like NL for Netherlands
+func SlugCountryRule(port PortEntry) []FieldError {
+	code, ok := countryCode(port.Country)
+	if !ok || strings.HasPrefix(port.Slug, code) {
+		return nil
+	}
+
+	return []FieldError{{Field: "slug", Reason: fmt.Sprintf("value must start with the country code %s", code), Rule: "country_prefix"}}
+}
+
+// validLocode reports whether the value has the shape of the UN/LOCODE, two letters of the ISO 3166 country
+// followed by three letters or digits 2-9 of the location
+func validLocode(value string) bool {
+	if len(value) != 5 {
+		return false
+	}
+
+	if _, ok := countries[value[:2]]; !ok || !isUpper(value[0]) || !isUpper(value[1]) {
+		return false
+	}
+
+	for i := 2; i < len(value); i++ {
+		if c := value[i]; !isUpper(c) && (c < '2' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isUpper(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+// countryCode returns the ISO 3166 code of the country given by its code or name
+func countryCode(country string) (string, bool) {
+	if code, ok := countries[country]; ok {
+		return code, true
+	}
+
+	code, ok := countries[countryKey(country)]
+
+	return code, ok
+}
+
+// countryKey normalizes the name of the country, so St. Kitts & Nevis matches Saint Kitts and Nevis
+// and Curaçao matches Curacao
+func countryKey(name string) string {
+	key, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), name)
+	if err != nil {
+		key = name
+	}
+
+	key = strings.NewReplacer("&", " and ", "’", "'").Replace(strings.ToLower(key))
+	key = strings.Join(strings.Fields(key), " ")
+	if strings.HasPrefix(key, "st. ") {
+		key = "saint " + key[len("st. "):]
+	}
+
+	return key
+}
+
+func loadCountries(table string) map[string]string {
+	records, err := csv.NewReader(strings.NewReader(table)).ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("invalid countries table: %s", err))
+	}
+
+	res := make(map[string]string, len(records)*2)
+	for _, record := range records[1:] {
+		code := record[0]
+		res[code] = code
+		res[countryKey(record[1])] = code
+		for _, alias := range strings.Split(record[2], "|") {
+			if alias != "" {
+				res[countryKey(alias)] = code
+			}
+		}
+	}
+
+	return res
+}
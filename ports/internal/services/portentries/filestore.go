@@ -0,0 +1,137 @@
+package portentries
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var _ PortsDB = &FileStore{}
+
+// FileStore is the port entries data layer kept in a single local file, it needs neither Docker nor Postgres
+// the file is an append only journal of port changes, one json encoded PortChange per line,
+// it is replayed into memory when opened, so the whole dataset is served from memory like MemStore does
+type FileStore struct {
+	*MemStore
+
+	mu   sync.Mutex
+	file journalFile
+}
+
+// journalFile is the part of the os.File the journal is written with
+type journalFile interface {
+	io.WriteSeeker
+	Truncate(size int64) error
+	Sync() error
+	Close() error
+}
+
+// OpenFileStore opens the journal file, creating it when missing, and loads its changes into memory
+func OpenFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	mem := NewMemStore()
+	size, err := replay(file, mem)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to load %s: %s", path, err)
+	}
+
+	// the truncated last line left by a crash is cut off, so the next change starts on its own line
+	if err := file.Truncate(size); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	if _, err := file.Seek(size, io.SeekStart); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	store := &FileStore{MemStore: mem, file: file}
+	mem.journal = store.append
+
+	return store, nil
+}
+
+// Close flushes and closes the journal file
+func (f *FileStore) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.file.Sync(); err != nil {
+		_ = f.file.Close()
+		return err
+	}
+
+	return f.file.Close()
+}
+
+// append writes the changes to the end of the journal, they are applied to memory only once written and synced,
+// the journal is cut back to where it was when any of them fails, so no torn line is left to break the replay
+func (f *FileStore) append(changes []PortChange) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	offset, err := f.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f.file)
+	enc := json.NewEncoder(w)
+	for _, change := range changes {
+		if err = enc.Encode(change); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.file.Sync()
+	}
+	if err == nil {
+		return nil
+	}
+
+	if truncErr := f.file.Truncate(offset); truncErr != nil {
+		return fmt.Errorf("%s, failed to roll the journal back: %s", err, truncErr)
+	}
+	if _, seekErr := f.file.Seek(offset, io.SeekStart); seekErr != nil {
+		return fmt.Errorf("%s, failed to roll the journal back: %s", err, seekErr)
+	}
+
+	return err
+}
+
+// replay applies every change of the journal to the store and returns the size of its complete lines,
+// a truncated last line left by a crash is ignored
+func replay(r io.Reader, mem *MemStore) (int64, error) {
+	br := bufio.NewReader(r)
+	var size int64
+	for {
+		line, err := br.ReadBytes('\n')
+		if err == io.EOF {
+			// the line without the line break was never fully written
+			return size, nil
+		}
+		if err != nil {
+			return size, err
+		}
+
+		var change PortChange
+		if err := json.Unmarshal(line, &change); err != nil {
+			return size, fmt.Errorf("line at offset %d: %s", size, err)
+		}
+
+		mem.apply(change)
+		size += int64(len(line))
+	}
+}
@@ -0,0 +1,107 @@
+package portentries
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// earthRadiusKm is the mean Earth radius used for great-circle distances
+	earthRadiusKm = 6371.0088
+	// maxDistanceKm is half of the Earth circumference, no two points are further apart
+	maxDistanceKm = math.Pi * earthRadiusKm
+	// initialSearchRadiusKm is the first search area radius when looking for nearest ports
+	initialSearchRadiusKm = 100
+	// defaultNearestLimit is the number of ports returned when the request has no limit
+	defaultNearestLimit = 10
+)
+
+// haversineSQL calculates great-circle distance in km between the port and the point,
+// it expects point latitude, latitude again and longitude as query args
+var haversineSQL = fmt.Sprintf(
+	"2 * %f * asin(least(1, sqrt(power(sin(radians(latitude - ?) / 2), 2) + "+
+		"cos(radians(?)) * cos(radians(latitude)) * power(sin(radians(longitude - ?) / 2), 2))))",
+	earthRadiusKm,
+)
+
+// NearestQuery describes the nearest ports lookup
+type NearestQuery struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+	Limit     int
+	Country   string
+}
+
+// PortDistance is a port with its distance from the searched location
+type PortDistance struct {
+	PortEntry
+	DistanceKm float64 `gorm:"column:distance_km"`
+}
+
+// boundingBox is a lat/lng rectangle containing every point within the radius, it makes location index usable
+type boundingBox struct {
+	MinLat, MaxLat float64
+	// lngRanges has two items when the box crosses the antimeridian
+	LngRanges [][2]float64
+}
+
+// haversine returns great-circle distance in km between two points
+func haversine(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Pow(math.Sin(dLng/2), 2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// newBoundingBox calculates the box around the point for the given radius,
+// the latitude out of range is clamped to the pole and the negative radius is the point itself
+func newBoundingBox(lat, lng, radiusKm float64) boundingBox {
+	lat = math.Max(-90, math.Min(90, lat))
+	angular := math.Max(0, radiusKm) / earthRadiusKm
+	box := boundingBox{
+		MinLat: lat - toDegrees(angular),
+		MaxLat: lat + toDegrees(angular),
+	}
+
+	// the circle contains a pole, so every longitude is inside of the box
+	if box.MinLat <= -90 || box.MaxLat >= 90 {
+		box.MinLat = math.Max(box.MinLat, -90)
+		box.MaxLat = math.Min(box.MaxLat, 90)
+		box.LngRanges = [][2]float64{{-180, 180}}
+
+		return box
+	}
+
+	ratio := math.Sin(angular) / math.Cos(toRadians(lat))
+	if angular >= math.Pi/2 || ratio >= 1 {
+		box.LngRanges = [][2]float64{{-180, 180}}
+
+		return box
+	}
+
+	dLng := toDegrees(math.Asin(ratio))
+	minLng, maxLng := lng-dLng, lng+dLng
+
+	switch {
+	case minLng < -180:
+		box.LngRanges = [][2]float64{{minLng + 360, 180}, {-180, maxLng}}
+	case maxLng > 180:
+		box.LngRanges = [][2]float64{{minLng, 180}, {-180, maxLng - 360}}
+	default:
+		box.LngRanges = [][2]float64{{minLng, maxLng}}
+	}
+
+	return box
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,126 @@
+package portentries
+
+import (
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestNewBoundingBox(t *testing.T) {
+	cases := []struct {
+		name      string
+		lat, lng  float64
+		radiusKm  float64
+		minLat    float64
+		maxLat    float64
+		lngRanges [][2]float64
+	}{
+		{
+			name:      "zero radius",
+			lat:       51.92,
+			lng:       4.48,
+			minLat:    51.92,
+			maxLat:    51.92,
+			lngRanges: [][2]float64{{4.48, 4.48}},
+		},
+		{
+			name:      "equator",
+			radiusKm:  111.2,
+			minLat:    -1,
+			maxLat:    1,
+			lngRanges: [][2]float64{{-1, 1}},
+		},
+		{
+			name:      "crossing antimeridian eastwards",
+			lng:       179.5,
+			radiusKm:  111.2,
+			minLat:    -1,
+			maxLat:    1,
+			lngRanges: [][2]float64{{178.5, 180}, {-180, -179.5}},
+		},
+		{
+			name:      "crossing antimeridian westwards",
+			lng:       -179.5,
+			radiusKm:  111.2,
+			minLat:    -1,
+			maxLat:    1,
+			lngRanges: [][2]float64{{179.5, 180}, {-180, -178.5}},
+		},
+		{
+			name:      "containing north pole",
+			lat:       89.5,
+			lng:       10,
+			radiusKm:  111.2,
+			minLat:    88.5,
+			maxLat:    90,
+			lngRanges: [][2]float64{{-180, 180}},
+		},
+		{
+			name:      "containing south pole",
+			lat:       -89.5,
+			lng:       10,
+			radiusKm:  111.2,
+			minLat:    -90,
+			maxLat:    -88.5,
+			lngRanges: [][2]float64{{-180, 180}},
+		},
+		{
+			name:      "radius wider than the earth",
+			radiusKm:  maxDistanceKm * 2,
+			minLat:    -90,
+			maxLat:    90,
+			lngRanges: [][2]float64{{-180, 180}},
+		},
+		{
+			name:      "negative radius",
+			lat:       51.92,
+			lng:       4.48,
+			radiusKm:  -10,
+			minLat:    51.92,
+			maxLat:    51.92,
+			lngRanges: [][2]float64{{4.48, 4.48}},
+		},
+		{
+			name:      "latitude out of range",
+			lat:       95,
+			radiusKm:  111.2,
+			minLat:    89,
+			maxLat:    90,
+			lngRanges: [][2]float64{{-180, 180}},
+		},
+		{
+			name:      "high latitude with every longitude",
+			lat:       80,
+			radiusKm:  1500,
+			minLat:    80 - 1500/earthRadiusKm*180/math.Pi,
+			maxLat:    90,
+			lngRanges: [][2]float64{{-180, 180}},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			box := newBoundingBox(test.lat, test.lng, test.radiusKm)
+			r.InDelta(test.minLat, box.MinLat, 0.01)
+			r.InDelta(test.maxLat, box.MaxLat, 0.01)
+			r.Len(box.LngRanges, len(test.lngRanges))
+			for i, lngRange := range test.lngRanges {
+				r.InDelta(lngRange[0], box.LngRanges[i][0], 0.01)
+				r.InDelta(lngRange[1], box.LngRanges[i][1], 0.01)
+			}
+		})
+	}
+}
+
+func TestHaversine(t *testing.T) {
+	r := require.New(t)
+
+	// Rotterdam to Antwerp
+	r.InDelta(78.5, haversine(51.92, 4.48, 51.22, 4.40), 1)
+	r.InDelta(0, haversine(10, 20, 10, 20), 0.001)
+	r.InDelta(maxDistanceKm, haversine(0, 0, 0, 180), 0.001)
+	// across the antimeridian the points are close
+	r.InDelta(111.2, haversine(0, 179.5, 0, -179.5), 0.5)
+}
@@ -0,0 +1,209 @@
+package portentries
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"github.com/kreyyser/transshipment/common/actor"
+	"gorm.io/gorm"
+	"time"
+)
+
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// asOfTableSQL rebuilds port_entries table from the latest history rows written up to the given time
+const asOfTableSQL = `(
+	SELECT (jsonb_populate_record(NULL::ports.port_entries, h.new_value)).*
+	FROM (
+		SELECT DISTINCT ON (port_id) operation, new_value
+		FROM ports.port_entry_history
+		WHERE changed_at <= ?
+		ORDER BY port_id, version DESC
+	) h
+	WHERE h.operation <> 'delete'
+) AS port_entries`
+
+// PortChange is a single versioned change of the port
+type PortChange struct {
+	ID        int64         `gorm:"primaryKey" json:"id"`
+	PortID    int64         `json:"port_id"`
+	Slug      string        `json:"slug"`
+	Version   int64         `json:"version"`
+	Operation string        `json:"operation"`
+	OldValue  *PortSnapshot `gorm:"type:jsonb" json:"old_value,omitempty"`
+	NewValue  *PortSnapshot `gorm:"type:jsonb" json:"new_value,omitempty"`
+	Actor     string        `json:"actor"`
+	ChangedAt time.Time     `json:"changed_at"`
+}
+
+// TableName sets proper table name for db queries with GORM ORM
+func (PortChange) TableName() string {
+	return "ports.port_entry_history"
+}
+
+// PortSnapshot is the port state stored as json in the history
+type PortSnapshot struct {
+	PortEntry
+}
+
+// Scan implements sql.Scanner interface
+func (s *PortSnapshot) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, &s.PortEntry)
+	case string:
+		return json.Unmarshal([]byte(v), &s.PortEntry)
+	default:
+		return errors.New("unsupported port snapshot value")
+	}
+}
+
+// Value implements driver.Valuer interface
+func (s PortSnapshot) Value() (driver.Value, error) {
+	return json.Marshal(s.PortEntry)
+}
+
+// History fetches every change of the port by id or slug ordered by version,
+// it works for already deleted ports as well
+func (d Datastore) History(ctx context.Context, id *int64, slug *string) ([]PortChange, error) {
+	q := d.db.WithContext(ctx)
+
+	if slug != nil {
+		q = q.Where("slug = ?", *slug)
+	} else {
+		q = q.Where("port_id = ?", *id)
+	}
+
+	var changes []PortChange
+	if res := q.Order("changed_at").Order("id").Find(&changes); res.Error != nil {
+		return nil, res.Error
+	}
+
+	if len(changes) == 0 {
+		return nil, ErrPortNotFound
+	}
+
+	return changes, nil
+}
+
+// FetchAsOf fetches single port by id or slug as it was at the given time
+func (d Datastore) FetchAsOf(ctx context.Context, id *int64, slug *string, asOf time.Time) (PortEntry, error) {
+	q := d.db.WithContext(ctx).Where("changed_at <= ?", asOf)
+
+	if slug != nil {
+		q = q.Where("slug = ?", *slug)
+	} else {
+		q = q.Where("port_id = ?", *id)
+	}
+
+	var change PortChange
+	res := q.Order("changed_at DESC").Order("id DESC").Limit(1).Find(&change)
+	if res.Error != nil {
+		return PortEntry{}, res.Error
+	}
+
+	if res.RowsAffected == 0 || change.Operation == OperationDelete || change.NewValue == nil {
+		return PortEntry{}, ErrPortNotFound
+	}
+
+	return change.NewValue.PortEntry, nil
+}
+
+// recordChanges writes history rows for the ports which were created or updated in the transaction,
+// before holds the ports state prior to the change keyed by slug and after holds the new state
+func recordChanges(ctx context.Context, tx *gorm.DB, before map[string]PortEntry, after []PortEntry) error {
+	changes := make([]PortChange, 0, len(after))
+	for _, port := range after {
+		change := PortChange{
+			PortID:    port.ID,
+			Slug:      port.Slug,
+			Operation: OperationCreate,
+			NewValue:  &PortSnapshot{port},
+		}
+
+		if old, ok := before[port.Slug]; ok {
+			if samePort(old, port) {
+				continue
+			}
+			change.Operation = OperationUpdate
+			change.OldValue = &PortSnapshot{old}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return saveChanges(ctx, tx, changes)
+}
+
+// recordDeletes writes history rows for the ports which were deleted in the transaction
+func recordDeletes(ctx context.Context, tx *gorm.DB, deleted []PortEntry) error {
+	changes := make([]PortChange, 0, len(deleted))
+	for _, port := range deleted {
+		changes = append(changes, PortChange{
+			PortID:    port.ID,
+			Slug:      port.Slug,
+			Operation: OperationDelete,
+			OldValue:  &PortSnapshot{port},
+		})
+	}
+
+	return saveChanges(ctx, tx, changes)
+}
+
+// saveChanges assigns actor and time to the changes and stores them,
+// change version is the port version after the change, deletion gets the next version
+func saveChanges(ctx context.Context, tx *gorm.DB, changes []PortChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	who := actor.FromContext(ctx)
+	for i := range changes {
+		if changes[i].NewValue != nil {
+			changes[i].Version = changes[i].NewValue.Version
+		} else {
+			changes[i].Version = changes[i].OldValue.Version + 1
+		}
+		changes[i].Actor = who
+		changes[i].ChangedAt = now
+	}
+
+	return tx.Create(&changes).Error
+}
+
+// samePort reports whether both ports hold the same data
+func samePort(a, b PortEntry) bool {
+	return a.ID == b.ID &&
+		a.Slug == b.Slug &&
+		a.Code == b.Code &&
+		a.Name == b.Name &&
+		a.City == b.City &&
+		a.Province == b.Province &&
+		a.Country == b.Country &&
+		sameStrings(a.Alias, b.Alias) &&
+		sameStrings(a.Regions, b.Regions) &&
+		a.Latitude == b.Latitude &&
+		a.Longitude == b.Longitude &&
+		a.Timezone == b.Timezone &&
+		sameStrings(a.Unlocks, b.Unlocks)
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,365 @@
+package portentries
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+const (
+	// importTTL is how long an unfinished import is kept before it is dropped
+	importTTL = 24 * time.Hour
+	// importBatchSize is the number of staged ports merged at once when the import finishes
+	importBatchSize = 500
+	// streamBatchSize is the number of ports StreamUpsertPorts collects before staging them
+	streamBatchSize = 500
+	// copyBatchSize is the number of ports StreamUpsertPorts collects before copying them with the copy loader
+	copyBatchSize = 10000
+	// maxStreamFailures is the number of failed ports StreamUpsertPorts reports
+	maxStreamFailures = 1000
+)
+
+// ErrImportNotFound is returned for an import which was never started, already finished or expired
+var ErrImportNotFound = errors.New("import not found")
+
+// ImportMode tells what happens to the ports missing from the import
+type ImportMode int
+
+const (
+	// ImportUpsert keeps the ports missing from the import
+	ImportUpsert ImportMode = iota
+	// ImportSync deletes the ports missing from the import, so the db matches the import exactly
+	ImportSync
+)
+
+// ImportLoader tells how the staged ports get into the db
+type ImportLoader string
+
+const (
+	// LoaderInsert stages the ports with INSERT and merges them batch by batch
+	LoaderInsert ImportLoader = ""
+	// LoaderCopy streams the ports into the staging table with COPY and merges them with a few set-based statements,
+	// meant for loading the whole dataset
+	LoaderCopy ImportLoader = "copy"
+)
+
+// ImportOptions describes how the staged ports are applied, the stored ports are changed according to Conflicts
+type ImportOptions struct {
+	Mode      ImportMode
+	DryRun    bool
+	Conflicts ConflictOptions
+}
+
+// ImportSummary counts the changes the import made or, on dry run, would make
+type ImportSummary struct {
+	Created   int64
+	Updated   int64
+	Unchanged int64
+	Removed   int64
+}
+
+// portImport is a started import
+type portImport struct {
+	ID        string
+	StartedAt time.Time
+	Loader    ImportLoader
+}
+
+// TableName sets proper table name for db queries with GORM ORM
+func (portImport) TableName() string {
+	return "ports.port_imports"
+}
+
+// portImportRow is a port staged by the import
+type portImportRow struct {
+	ImportID string
+	Slug     string
+	Data     *PortSnapshot `gorm:"type:jsonb"`
+}
+
+// TableName sets proper table name for db queries with GORM ORM
+func (portImportRow) TableName() string {
+	return "ports.port_import_rows"
+}
+
+// StartImport opens a new import loaded by the loader and drops the expired ones
+func (d Datastore) StartImport(ctx context.Context, loader ImportLoader) (string, error) {
+	id, err := newImportID()
+	if err != nil {
+		return "", err
+	}
+
+	err = d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("started_at < ?", time.Now().Add(-importTTL)).Delete(&portImport{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&portImport{ID: id, StartedAt: time.Now().UTC(), Loader: loader}).Error
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// StageImport stores the ports in the import, a port staged again under the same slug replaces the previous one
+func (d Datastore) StageImport(ctx context.Context, importID string, ports []PortEntry) error {
+	var imp portImport
+	res := d.db.WithContext(ctx).Where("id = ?", importID).Find(&imp)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrImportNotFound
+	}
+
+	if imp.Loader == LoaderCopy {
+		return d.copyImport(ctx, importID, ports)
+	}
+
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockImport(tx, importID); err != nil {
+			return err
+		}
+
+		rows := make([]portImportRow, 0, len(ports))
+		for _, p := range dedupeSlugs(ports) {
+			rows = append(rows, portImportRow{ImportID: importID, Slug: p.Slug, Data: &PortSnapshot{p}})
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "import_id"}, {Name: "slug"}},
+			DoUpdates: clause.AssignmentColumns([]string{"data"}),
+		}).Create(&rows).Error
+	})
+}
+
+// FinishImport applies the staged ports in a single transaction and closes the import,
+// with DryRun set the ports are left untouched and only the summary is returned
+func (d Datastore) FinishImport(ctx context.Context, importID string, opts ImportOptions) (ImportSummary, error) {
+	var summary ImportSummary
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		imp, err := lockImport(tx, importID)
+		if err != nil {
+			return err
+		}
+
+		if imp.Loader == LoaderCopy || (opts.Mode == ImportSync && !opts.DryRun) {
+			// ports created meanwhile by someone else would survive the sync otherwise,
+			// the copy loader counts the changes up front, so they must not change under it
+			if err := tx.Exec("LOCK TABLE ports.port_entries IN SHARE ROW EXCLUSIVE MODE").Error; err != nil {
+				return err
+			}
+		}
+
+		if imp.Loader == LoaderCopy {
+			summary, err = mergeCopied(ctx, tx, importID, opts)
+		} else {
+			summary, err = mergeStaged(ctx, tx, importID, opts)
+		}
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", importID).Delete(&portImport{}).Error
+	})
+	if err != nil {
+		return ImportSummary{}, err
+	}
+
+	return summary, nil
+}
+
+// AbortImport drops the import with its staged ports
+func (d Datastore) AbortImport(ctx context.Context, importID string) error {
+	res := d.db.WithContext(ctx).Where("id = ?", importID).Delete(&portImport{})
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ErrImportNotFound
+	}
+
+	return nil
+}
+
+// mergeStaged merges the staged ports batch by batch, sync mode deletes the ports which were not staged
+func mergeStaged(ctx context.Context, tx *gorm.DB, importID string, opts ImportOptions) (ImportSummary, error) {
+	var summary ImportSummary
+
+	// every batch is merged to find all the conflicts, the transaction is rolled back on any of them
+	conflicts := &ConflictError{}
+	after := ""
+	for {
+		var rows []portImportRow
+		res := tx.Where("import_id = ? AND slug > ?", importID, after).Order("slug").Limit(importBatchSize).Find(&rows)
+		if res.Error != nil {
+			return summary, res.Error
+		}
+
+		if len(rows) == 0 {
+			break
+		}
+		after = rows[len(rows)-1].Slug
+
+		if err := mergeImported(ctx, tx, rows, opts, &summary, conflicts); err != nil {
+			return summary, err
+		}
+	}
+
+	if err := conflicts.err(); err != nil {
+		return summary, err
+	}
+
+	if opts.Mode != ImportSync {
+		return summary, nil
+	}
+
+	var removed []PortEntry
+	res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("slug NOT IN (SELECT slug FROM ports.port_import_rows WHERE import_id = ?)", importID).
+		Find(&removed)
+	if res.Error != nil {
+		return summary, res.Error
+	}
+	summary.Removed = int64(len(removed))
+
+	if opts.DryRun || len(removed) == 0 {
+		return summary, nil
+	}
+
+	ids := make([]int64, 0, len(removed))
+	for _, p := range removed {
+		ids = append(ids, p.ID)
+	}
+
+	if err := tx.Where("id IN ?", ids).Delete(&PortEntry{}).Error; err != nil {
+		return summary, err
+	}
+
+	return summary, recordDeletes(ctx, tx, removed)
+}
+
+// mergeImported upserts the batch of staged ports which differ from the stored ones and counts them,
+// the stored ports are changed according to the conflict policies, the conflicts are collected instead
+func mergeImported(ctx context.Context, tx *gorm.DB, rows []portImportRow, opts ImportOptions, summary *ImportSummary, conflicts *ConflictError) error {
+	slugs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		slugs = append(slugs, row.Slug)
+	}
+
+	var existing []PortEntry
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("slug IN ?", slugs).Find(&existing).Error; err != nil {
+		return err
+	}
+	before := bySlug(existing)
+
+	changed := make([]PortEntry, 0, len(rows))
+	for _, row := range rows {
+		port := importedPort(row)
+
+		old, ok := before[port.Slug]
+		if ok {
+			var fields []string
+			if port, fields = opts.Conflicts.resolve(old, port); len(fields) > 0 {
+				conflicts.add(port.Slug, fields)
+				continue
+			}
+		}
+
+		switch {
+		case !ok:
+			summary.Created++
+		case sameImported(old, port):
+			summary.Unchanged++
+			continue
+		default:
+			summary.Updated++
+		}
+
+		changed = append(changed, port)
+	}
+
+	if opts.DryRun || conflicts.Total > 0 || len(changed) == 0 {
+		return nil
+	}
+
+	if err := tx.Model(&PortEntry{}).Clauses(upsertClause()).Create(&changed).Error; err != nil {
+		return err
+	}
+
+	if err := reloadUpserted(tx, changed); err != nil {
+		return err
+	}
+
+	return recordChanges(ctx, tx, before, changed)
+}
+
+// lockImport locks the import so it is not finished twice, it fails when the import does not exist
+func lockImport(tx *gorm.DB, importID string) (portImport, error) {
+	var imp portImport
+	res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", importID).Find(&imp)
+	if res.Error != nil {
+		return portImport{}, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return portImport{}, ErrImportNotFound
+	}
+
+	return imp, nil
+}
+
+// importedPort turns the staged row back into a port, id and version always come from the db
+func importedPort(row portImportRow) PortEntry {
+	var port PortEntry
+	if row.Data != nil {
+		port = row.Data.PortEntry
+	}
+	port.ID = 0
+	port.Version = 0
+	port.Slug = row.Slug
+
+	return port
+}
+
+// sameImported reports whether the imported port holds the same data as the stored one
+func sameImported(stored, imported PortEntry) bool {
+	imported.ID = stored.ID
+
+	return samePort(stored, imported)
+}
+
+// dedupeSlugs keeps the last port of every slug
+func dedupeSlugs(ports []PortEntry) []PortEntry {
+	index := make(map[string]int, len(ports))
+	res := make([]PortEntry, 0, len(ports))
+	for _, p := range ports {
+		if i, ok := index[p.Slug]; ok {
+			res[i] = p
+			continue
+		}
+
+		index[p.Slug] = len(res)
+		res = append(res, p)
+	}
+
+	return res
+}
+
+// newImportID returns a random import id
+func newImportID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,247 @@
+package portentries
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+const (
+	ImportJobPending   = "pending"
+	ImportJobRunning   = "running"
+	ImportJobSucceeded = "succeeded"
+	ImportJobFailed    = "failed"
+)
+
+// maxImportJobErrors limits the number of errors and record errors kept per job,
+// the failed counter keeps counting past it
+const maxImportJobErrors = 100
+
+var (
+	// ErrImportJobNotFound is returned for unknown import job id
+	ErrImportJobNotFound = errors.New("import job not found")
+	// ErrImportJobFinished is returned on update of already succeeded or failed import job
+	ErrImportJobFinished = errors.New("import job already finished")
+)
+
+// ImportJob tracks the progress of the import run in the background
+type ImportJob struct {
+	ID           int64 `gorm:"primaryKey"`
+	Status       string
+	Mode         ImportMode
+	DryRun       bool
+	FileName     string
+	Format       string
+	SlugProperty string
+	Loader       ImportLoader
+	Conflicts    ConflictOptions `gorm:"embedded;embeddedPrefix:conflict_"`
+	Actor        string
+	ImportID     string
+	Processed    int64
+	Failed       int64
+	Errors       pq.StringArray `gorm:"type:text[]"`
+	RecordErrors RecordErrors   `gorm:"type:jsonb"`
+	// Warned counts the records breaking the domain rules, RecordWarnings keep the first of them
+	Warned         int64
+	RecordWarnings RecordErrors  `gorm:"type:jsonb"`
+	Summary        ImportSummary `gorm:"embedded"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	FinishedAt     *time.Time
+}
+
+// TableName sets proper table name for db queries with GORM ORM
+func (ImportJob) TableName() string {
+	return "ports.import_jobs"
+}
+
+// RecordError is a record of the imported file which failed
+type RecordError struct {
+	Key    string       `json:"key"`
+	Offset int64        `json:"offset"`
+	Errors []FieldError `json:"errors"`
+	File   string       `json:"file,omitempty"`
+}
+
+// FieldError tells why the field of the record is invalid, Rule names the validation or domain rule it breaks
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+	Rule   string `json:"rule,omitempty"`
+}
+
+// RecordErrors are stored as json in the import job
+type RecordErrors []RecordError
+
+// Scan implements sql.Scanner interface
+func (e *RecordErrors) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*e = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	default:
+		return errors.New("unsupported record errors value")
+	}
+}
+
+// Value implements driver.Valuer interface
+func (e RecordErrors) Value() (driver.Value, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	return json.Marshal([]RecordError(e))
+}
+
+// ImportJobUpdate describes the progress of the import job, counters replace the stored ones
+// and errors are appended to the stored ones, unless the job is restarted with a new import id
+type ImportJobUpdate struct {
+	ID           int64
+	Status       string
+	ImportID     string
+	Processed    int64
+	Failed       int64
+	Errors       []string
+	RecordErrors []RecordError
+	// Warned replaces the stored count as well and RecordWarnings are appended to the stored ones
+	Warned         int64
+	RecordWarnings []RecordError
+	Summary        *ImportSummary
+}
+
+// ImportJobsQuery describes the page of import jobs, the newest jobs come first
+type ImportJobsQuery struct {
+	Limit    int
+	Before   int64
+	Statuses []string
+}
+
+// CreateImportJob stores new pending import job
+func (d Datastore) CreateImportJob(ctx context.Context, job *ImportJob) error {
+	job.Status = ImportJobPending
+
+	return d.db.WithContext(ctx).Create(job).Error
+}
+
+// UpdateImportJob applies the progress to the import job and returns its new state
+func (d Datastore) UpdateImportJob(ctx context.Context, update ImportJobUpdate) (ImportJob, error) {
+	var job ImportJob
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", update.ID).Find(&job)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return ErrImportJobNotFound
+		}
+
+		if err := applyJobUpdate(&job, update); err != nil {
+			return err
+		}
+
+		return tx.Save(&job).Error
+	})
+	if err != nil {
+		return ImportJob{}, err
+	}
+
+	return job, nil
+}
+
+// FetchImportJob fetches single import job by id
+func (d Datastore) FetchImportJob(ctx context.Context, id int64) (ImportJob, error) {
+	var job ImportJob
+	res := d.db.WithContext(ctx).Where("id = ?", id).Find(&job)
+	if res.Error != nil {
+		return ImportJob{}, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ImportJob{}, ErrImportJobNotFound
+	}
+
+	return job, nil
+}
+
+// ListImportJobs fetches the page of import jobs, the newest come first
+func (d Datastore) ListImportJobs(ctx context.Context, query ImportJobsQuery) ([]ImportJob, error) {
+	q := d.db.WithContext(ctx)
+
+	if len(query.Statuses) > 0 {
+		q = q.Where("status IN ?", query.Statuses)
+	}
+
+	if query.Before > 0 {
+		q = q.Where("id < ?", query.Before)
+	}
+
+	var jobs []ImportJob
+	if res := q.Order("id DESC").Limit(query.Limit).Find(&jobs); res.Error != nil {
+		return nil, res.Error
+	}
+
+	return jobs, nil
+}
+
+// applyJobUpdate changes the job according to the update, finished jobs are not changed anymore
+func applyJobUpdate(job *ImportJob, update ImportJobUpdate) error {
+	if job.FinishedAt != nil {
+		return ErrImportJobFinished
+	}
+
+	now := time.Now().UTC()
+	if update.Status != "" {
+		job.Status = update.Status
+	}
+	if job.Status == ImportJobSucceeded || job.Status == ImportJobFailed {
+		job.FinishedAt = &now
+	}
+
+	if update.ImportID != "" && update.ImportID != job.ImportID {
+		job.ImportID = update.ImportID
+		job.Errors = nil
+		job.RecordErrors = nil
+		job.RecordWarnings = nil
+	}
+	job.Processed = update.Processed
+	job.Failed = update.Failed
+	job.Warned = update.Warned
+
+	for _, e := range update.Errors {
+		if len(job.Errors) >= maxImportJobErrors {
+			break
+		}
+		job.Errors = append(job.Errors, e)
+	}
+
+	for _, e := range update.RecordErrors {
+		if len(job.RecordErrors) >= maxImportJobErrors {
+			break
+		}
+		job.RecordErrors = append(job.RecordErrors, e)
+	}
+
+	for _, e := range update.RecordWarnings {
+		if len(job.RecordWarnings) >= maxImportJobErrors {
+			break
+		}
+		job.RecordWarnings = append(job.RecordWarnings, e)
+	}
+
+	if update.Summary != nil {
+		job.Summary = *update.Summary
+	}
+	job.UpdatedAt = now
+
+	return nil
+}
@@ -0,0 +1,791 @@
+package portentries
+
+import (
+	"context"
+	"github.com/kreyyser/transshipment/common/actor"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ PortsDB = &MemStore{}
+
+// MemStore is the port entries data layer kept in memory, it is meant for local runs and integration tests
+// it follows Datastore semantics: ports are upserted by slug, versioned and every change is kept in the history
+type MemStore struct {
+	mu           sync.RWMutex
+	ports        map[int64]PortEntry
+	slugs        map[string]int64
+	history      []PortChange
+	lastID       int64
+	lastChangeID int64
+	imports      map[string]*memImport
+	jobs         map[int64]ImportJob
+	lastJobID    int64
+
+	// journal persists the changes before they are applied, FileStore uses it to append them to the file
+	journal func(changes []PortChange) error
+}
+
+// NewMemStore returns a new empty MemStore
+func NewMemStore() *MemStore {
+	return &MemStore{
+		ports:   map[int64]PortEntry{},
+		slugs:   map[string]int64{},
+		imports: map[string]*memImport{},
+		jobs:    map[int64]ImportJob{},
+	}
+}
+
+// memImport is a started import with its staged ports keyed by slug
+type memImport struct {
+	startedAt time.Time
+	ports     map[string]PortEntry
+}
+
+// List fetches ports list from the memory
+func (m *MemStore) List(_ context.Context, query ListQuery) ([]PortEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	source := m.current()
+	if query.AsOf != nil {
+		source = m.snapshotAt(*query.AsOf)
+	}
+
+	ports := make([]PortEntry, 0, len(source))
+	for _, p := range source {
+		if !matchesListQuery(p, query) {
+			continue
+		}
+
+		if query.After != nil {
+			cmp := compareSortKey(query.OrderBy, p, query.After.Value, query.After.ID)
+			if (!query.Descending && cmp <= 0) || (query.Descending && cmp >= 0) {
+				continue
+			}
+		}
+
+		ports = append(ports, clonePort(p))
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		cmp := compareSortKey(query.OrderBy, ports[i], sortValue(query.OrderBy, ports[j]), ports[j].ID)
+		if query.Descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+
+	if query.Limit > 0 && len(ports) > query.Limit {
+		ports = ports[:query.Limit]
+	}
+
+	return ports, nil
+}
+
+// Upsert creates or updates port in the memory, the stored port is changed according to the conflict policies
+func (m *MemStore) Upsert(ctx context.Context, port PortEntry, conflicts ConflictOptions) (PortEntry, error) {
+	results, err := m.BulkUpsert(ctx, []PortEntry{port}, conflicts)
+	if err != nil {
+		return PortEntry{}, err
+	}
+
+	return results[0].PortEntry, nil
+}
+
+// BulkUpsert creates or updates ports in the memory, results follow the order of the ports,
+// the stored ports are changed according to the conflict policies and *ConflictError fails the whole batch
+func (m *MemStore) BulkUpsert(ctx context.Context, ports []PortEntry, conflicts ConflictOptions) ([]UpsertResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// planned holds the ports changed earlier in the same batch
+	planned := map[string]PortEntry{}
+	results := make([]UpsertResult, 0, len(ports))
+	changes := make([]PortChange, 0, len(ports))
+	conflicted := &ConflictError{}
+	lastID := m.lastID
+
+	for _, port := range ports {
+		old, ok := planned[port.Slug]
+		if !ok {
+			old, ok = m.bySlug(port.Slug)
+		}
+
+		status := UpsertCreated
+		if ok {
+			var fields []string
+			if port, fields = conflicts.resolve(old, port); len(fields) > 0 {
+				conflicted.add(port.Slug, fields)
+				continue
+			}
+
+			port.ID = old.ID
+			port.Version = old.Version
+			status = UpsertUnchanged
+			if !samePort(old, port) {
+				port.Version++
+				status = UpsertUpdated
+				changes = append(changes, newChange(OperationUpdate, &old, &port))
+			}
+		} else {
+			m.lastID++
+			port.ID = m.lastID
+			port.Version = 1
+			changes = append(changes, newChange(OperationCreate, nil, &port))
+		}
+
+		planned[port.Slug] = port
+		results = append(results, UpsertResult{PortEntry: port, Status: status})
+	}
+
+	if err := conflicted.err(); err != nil {
+		m.lastID = lastID
+		return []UpsertResult{}, err
+	}
+
+	if err := m.commit(ctx, changes); err != nil {
+		return []UpsertResult{}, err
+	}
+
+	return results, nil
+}
+
+// Fetch fetches single port by id or slug
+func (m *MemStore) Fetch(_ context.Context, id *int64, slug *string) (PortEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var (
+		port PortEntry
+		ok   bool
+	)
+	if slug != nil {
+		port, ok = m.bySlug(*slug)
+	} else {
+		port, ok = m.ports[*id]
+	}
+
+	if !ok {
+		return PortEntry{}, ErrPortNotFound
+	}
+
+	return clonePort(port), nil
+}
+
+// BatchFetch fetches the ports matching any of the keys from the memory, ordered by id
+func (m *MemStore) BatchFetch(_ context.Context, keys PortKeys) ([]PortEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	found := map[int64]bool{}
+	for _, id := range keys.IDs {
+		if _, ok := m.ports[id]; ok {
+			found[id] = true
+		}
+	}
+
+	for _, slug := range keys.Slugs {
+		if id, ok := m.slugs[slug]; ok {
+			found[id] = true
+		}
+	}
+
+	if len(keys.Unlocks) > 0 {
+		for id, p := range m.ports {
+			for _, unlock := range p.Unlocks {
+				if containsString(keys.Unlocks, unlock) {
+					found[id] = true
+				}
+			}
+		}
+	}
+
+	ports := make([]PortEntry, 0, len(found))
+	for id := range found {
+		ports = append(ports, clonePort(m.ports[id]))
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i].ID < ports[j].ID })
+
+	return ports, nil
+}
+
+// Store creates new port in the memory
+func (m *MemStore) Store(ctx context.Context, port *PortEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.slugs[port.Slug]; ok {
+		return ErrPortExists
+	}
+
+	m.lastID++
+	port.ID = m.lastID
+	port.Version = 1
+
+	return m.commit(ctx, []PortChange{newChange(OperationCreate, nil, port)})
+}
+
+// Update updates the port in the memory
+// port version has to match the stored one, otherwise ErrVersionConflict is returned
+func (m *MemStore) Update(ctx context.Context, port *PortEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	old, ok := m.ports[port.ID]
+	if !ok {
+		return ErrPortNotFound
+	}
+
+	if old.Version != port.Version {
+		return ErrVersionConflict
+	}
+
+	if samePort(old, *port) {
+		return nil
+	}
+
+	if id, ok := m.slugs[port.Slug]; ok && id != port.ID {
+		return ErrPortExists
+	}
+
+	updated := *port
+	updated.Version++
+	if err := m.commit(ctx, []PortChange{newChange(OperationUpdate, &old, &updated)}); err != nil {
+		return err
+	}
+	port.Version = updated.Version
+
+	return nil
+}
+
+// Delete deletes the port given by id or slug from the memory, ErrMissingPortKey is returned when both are nil
+// and ErrPortNotFound when no port matches them,
+// when version is given it has to match the stored one, otherwise ErrVersionConflict is returned
+func (m *MemStore) Delete(ctx context.Context, id *int64, slug *string, version *int64) error {
+	if id == nil && slug == nil {
+		return ErrMissingPortKey
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var (
+		port PortEntry
+		ok   bool
+	)
+	if id != nil {
+		port, ok = m.ports[*id]
+		ok = ok && (slug == nil || port.Slug == *slug)
+	} else if slug != nil {
+		port, ok = m.bySlug(*slug)
+	}
+
+	if !ok {
+		return ErrPortNotFound
+	}
+
+	if version != nil && port.Version != *version {
+		return ErrVersionConflict
+	}
+
+	return m.commit(ctx, []PortChange{newChange(OperationDelete, &port, nil)})
+}
+
+// Nearest fetches ports closest to the location ordered by distance
+func (m *MemStore) Nearest(_ context.Context, query NearestQuery) ([]PortDistance, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	radius := query.RadiusKm
+	if radius <= 0 {
+		radius = maxDistanceKm
+	}
+
+	var ports []PortDistance
+	for _, p := range m.ports {
+		if query.Country != "" && !strings.EqualFold(p.Country, query.Country) {
+			continue
+		}
+
+		distance := haversine(query.Latitude, query.Longitude, p.Latitude, p.Longitude)
+		if distance <= radius {
+			ports = append(ports, PortDistance{PortEntry: clonePort(p), DistanceKm: distance})
+		}
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].DistanceKm != ports[j].DistanceKm {
+			return ports[i].DistanceKm < ports[j].DistanceKm
+		}
+		return ports[i].ID < ports[j].ID
+	})
+
+	if query.Limit > 0 && len(ports) > query.Limit {
+		ports = ports[:query.Limit]
+	}
+
+	return ports, nil
+}
+
+// Search fetches ports fuzzy matching the text ordered by relevance
+// the ranking mirrors the one of Datastore.Search using in-process trigram similarity
+func (m *MemStore) Search(_ context.Context, query SearchQuery) ([]PortMatch, error) {
+	text := foldText(normalizeSearchText(query.Text))
+	if text == "" {
+		return []PortMatch{}, nil
+	}
+	upper := strings.ToUpper(strings.ReplaceAll(text, " ", ""))
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var ports []PortMatch
+	for _, p := range m.ports {
+		codes := strings.Join(append([]string{p.Code}, p.Unlocks...), " ")
+		document := foldText(strings.Join([]string{p.Name, p.City, strings.Join(p.Alias, " "), codes}, " "))
+		if wordSimilarity(text, document) < searchThreshold {
+			continue
+		}
+
+		score := math.Max(
+			wordSimilarity(text, foldText(p.Name)),
+			math.Max(
+				wordSimilarity(text, foldText(strings.Join(p.Alias, " ")))*0.95,
+				math.Max(wordSimilarity(text, foldText(p.City))*0.9, wordSimilarity(text, foldText(codes))*0.9),
+			),
+		)
+		if strings.ToUpper(p.Code) == upper || p.Slug == upper || containsString(p.Unlocks, upper) {
+			score = 1
+		}
+
+		ports = append(ports, PortMatch{PortEntry: clonePort(p), Score: score})
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].Score != ports[j].Score {
+			return ports[i].Score > ports[j].Score
+		}
+		return ports[i].ID < ports[j].ID
+	})
+
+	if query.Limit > 0 && len(ports) > query.Limit {
+		ports = ports[:query.Limit]
+	}
+
+	return ports, nil
+}
+
+// FetchAsOf fetches single port by id or slug as it was at the given time
+func (m *MemStore) FetchAsOf(_ context.Context, id *int64, slug *string, asOf time.Time) (PortEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for i := len(m.history) - 1; i >= 0; i-- {
+		change := m.history[i]
+		if change.ChangedAt.After(asOf) || !matchesChange(change, id, slug) {
+			continue
+		}
+
+		if change.Operation == OperationDelete || change.NewValue == nil {
+			break
+		}
+
+		return clonePort(change.NewValue.PortEntry), nil
+	}
+
+	return PortEntry{}, ErrPortNotFound
+}
+
+// History fetches every change of the port by id or slug ordered by version
+func (m *MemStore) History(_ context.Context, id *int64, slug *string) ([]PortChange, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var changes []PortChange
+	for _, change := range m.history {
+		if matchesChange(change, id, slug) {
+			changes = append(changes, change)
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, ErrPortNotFound
+	}
+
+	return changes, nil
+}
+
+// StartImport opens a new import and drops the expired ones, every loader stages the ports in memory the same way
+func (m *MemStore) StartImport(_ context.Context, _ ImportLoader) (string, error) {
+	id, err := newImportID()
+	if err != nil {
+		return "", err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, imp := range m.imports {
+		if time.Since(imp.startedAt) > importTTL {
+			delete(m.imports, key)
+		}
+	}
+	m.imports[id] = &memImport{startedAt: time.Now(), ports: map[string]PortEntry{}}
+
+	return id, nil
+}
+
+// StageImport stores the ports in the import, a port staged again under the same slug replaces the previous one
+func (m *MemStore) StageImport(_ context.Context, importID string, ports []PortEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	imp, ok := m.imports[importID]
+	if !ok {
+		return ErrImportNotFound
+	}
+
+	for _, p := range ports {
+		imp.ports[p.Slug] = clonePort(p)
+	}
+
+	return nil
+}
+
+// FinishImport applies the staged ports at once and closes the import,
+// with DryRun set the ports are left untouched and only the summary is returned
+func (m *MemStore) FinishImport(ctx context.Context, importID string, opts ImportOptions) (ImportSummary, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	imp, ok := m.imports[importID]
+	if !ok {
+		return ImportSummary{}, ErrImportNotFound
+	}
+
+	slugs := make([]string, 0, len(imp.ports))
+	for slug := range imp.ports {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	var (
+		summary   ImportSummary
+		changes   []PortChange
+		lastID    = m.lastID
+		conflicts = &ConflictError{}
+	)
+	for _, slug := range slugs {
+		port := imp.ports[slug]
+		port.Slug = slug
+
+		old, ok := m.bySlug(slug)
+		if ok {
+			var fields []string
+			if port, fields = opts.Conflicts.resolve(old, port); len(fields) > 0 {
+				conflicts.add(slug, fields)
+				continue
+			}
+		}
+
+		switch {
+		case !ok:
+			summary.Created++
+			lastID++
+			port.ID, port.Version = lastID, 1
+			changes = append(changes, newChange(OperationCreate, nil, &port))
+		case sameImported(old, port):
+			summary.Unchanged++
+		default:
+			summary.Updated++
+			port.ID, port.Version = old.ID, old.Version+1
+			changes = append(changes, newChange(OperationUpdate, &old, &port))
+		}
+	}
+
+	if err := conflicts.err(); err != nil {
+		return ImportSummary{}, err
+	}
+
+	if opts.Mode == ImportSync {
+		for _, p := range m.current() {
+			if _, ok := imp.ports[p.Slug]; !ok {
+				p := p
+				summary.Removed++
+				changes = append(changes, newChange(OperationDelete, &p, nil))
+			}
+		}
+	}
+
+	if !opts.DryRun {
+		if err := m.commit(ctx, changes); err != nil {
+			return ImportSummary{}, err
+		}
+	}
+	delete(m.imports, importID)
+
+	return summary, nil
+}
+
+// AbortImport drops the import with its staged ports
+func (m *MemStore) AbortImport(_ context.Context, importID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.imports[importID]; !ok {
+		return ErrImportNotFound
+	}
+	delete(m.imports, importID)
+
+	return nil
+}
+
+// CreateImportJob stores new pending import job, jobs are kept in memory only even by FileStore
+func (m *MemStore) CreateImportJob(_ context.Context, job *ImportJob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now().UTC()
+	m.lastJobID++
+	job.ID = m.lastJobID
+	job.Status = ImportJobPending
+	job.CreatedAt, job.UpdatedAt = now, now
+	m.jobs[job.ID] = *job
+
+	return nil
+}
+
+// UpdateImportJob applies the progress to the import job and returns its new state
+func (m *MemStore) UpdateImportJob(_ context.Context, update ImportJobUpdate) (ImportJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[update.ID]
+	if !ok {
+		return ImportJob{}, ErrImportJobNotFound
+	}
+
+	job.Errors = append(job.Errors[:0:0], job.Errors...)
+	job.RecordErrors = append(job.RecordErrors[:0:0], job.RecordErrors...)
+	job.RecordWarnings = append(job.RecordWarnings[:0:0], job.RecordWarnings...)
+	if err := applyJobUpdate(&job, update); err != nil {
+		return ImportJob{}, err
+	}
+	m.jobs[job.ID] = job
+
+	return job, nil
+}
+
+// FetchImportJob fetches single import job by id
+func (m *MemStore) FetchImportJob(_ context.Context, id int64) (ImportJob, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return ImportJob{}, ErrImportJobNotFound
+	}
+
+	return job, nil
+}
+
+// ListImportJobs fetches the page of import jobs, the newest come first
+func (m *MemStore) ListImportJobs(_ context.Context, query ImportJobsQuery) ([]ImportJob, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	jobs := make([]ImportJob, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		if query.Before > 0 && job.ID >= query.Before {
+			continue
+		}
+		if len(query.Statuses) > 0 && !containsString(query.Statuses, job.Status) {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ID > jobs[j].ID
+	})
+
+	if query.Limit > 0 && len(jobs) > query.Limit {
+		jobs = jobs[:query.Limit]
+	}
+
+	return jobs, nil
+}
+
+// commit stamps the changes, passes them to the journal and applies them, the store has to be locked
+func (m *MemStore) commit(ctx context.Context, changes []PortChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	who := actor.FromContext(ctx)
+	for i := range changes {
+		m.lastChangeID++
+		changes[i].ID = m.lastChangeID
+		changes[i].Actor = who
+		changes[i].ChangedAt = now
+	}
+
+	if m.journal != nil {
+		if err := m.journal(changes); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range changes {
+		m.apply(change)
+	}
+
+	return nil
+}
+
+// apply changes the store state according to the change, the store has to be locked
+func (m *MemStore) apply(change PortChange) {
+	switch change.Operation {
+	case OperationDelete:
+		delete(m.ports, change.PortID)
+		delete(m.slugs, change.Slug)
+	default:
+		if old, ok := m.ports[change.PortID]; ok && old.Slug != change.Slug {
+			delete(m.slugs, old.Slug)
+		}
+		m.ports[change.PortID] = clonePort(change.NewValue.PortEntry)
+		m.slugs[change.Slug] = change.PortID
+	}
+
+	m.history = append(m.history, change)
+
+	if change.PortID > m.lastID {
+		m.lastID = change.PortID
+	}
+	if change.ID > m.lastChangeID {
+		m.lastChangeID = change.ID
+	}
+}
+
+func (m *MemStore) bySlug(slug string) (PortEntry, bool) {
+	id, ok := m.slugs[slug]
+	if !ok {
+		return PortEntry{}, false
+	}
+
+	return m.ports[id], true
+}
+
+func (m *MemStore) current() []PortEntry {
+	ports := make([]PortEntry, 0, len(m.ports))
+	for _, p := range m.ports {
+		ports = append(ports, p)
+	}
+
+	return ports
+}
+
+// snapshotAt rebuilds the ports as they were at the given time out of the history
+func (m *MemStore) snapshotAt(asOf time.Time) []PortEntry {
+	latest := map[int64]PortChange{}
+	for _, change := range m.history {
+		if !change.ChangedAt.After(asOf) {
+			latest[change.PortID] = change
+		}
+	}
+
+	ports := make([]PortEntry, 0, len(latest))
+	for _, change := range latest {
+		if change.Operation != OperationDelete && change.NewValue != nil {
+			ports = append(ports, change.NewValue.PortEntry)
+		}
+	}
+
+	return ports
+}
+
+// newChange builds history entry of the port change, version is the port version after the change
+func newChange(operation string, before, after *PortEntry) PortChange {
+	change := PortChange{Operation: operation}
+
+	if before != nil {
+		change.PortID, change.Slug = before.ID, before.Slug
+		change.OldValue = &PortSnapshot{clonePort(*before)}
+		change.Version = before.Version + 1
+	}
+
+	if after != nil {
+		change.PortID, change.Slug = after.ID, after.Slug
+		change.NewValue = &PortSnapshot{clonePort(*after)}
+		change.Version = after.Version
+	}
+
+	return change
+}
+
+func matchesListQuery(p PortEntry, query ListQuery) bool {
+	switch {
+	case query.Country != "" && !strings.EqualFold(p.Country, query.Country):
+		return false
+	case query.Province != "" && !strings.EqualFold(p.Province, query.Province):
+		return false
+	case query.Region != "" && !containsString(p.Regions, query.Region):
+		return false
+	case query.Timezone != "" && p.Timezone != query.Timezone:
+		return false
+	case query.CodePrefix != "" && !strings.HasPrefix(p.Code, query.CodePrefix):
+		return false
+	}
+
+	return true
+}
+
+func matchesChange(change PortChange, id *int64, slug *string) bool {
+	if slug != nil {
+		return change.Slug == *slug
+	}
+
+	return change.PortID == *id
+}
+
+// compareSortKey compares the port with the (value, id) sort key the same way Datastore.List orders ports
+func compareSortKey(orderBy string, p PortEntry, value string, id int64) int {
+	if _, ok := sortableColumns[orderBy]; ok && orderBy != "id" {
+		if cmp := strings.Compare(sortValue(orderBy, p), value); cmp != 0 {
+			return cmp
+		}
+	}
+
+	switch {
+	case p.ID < id:
+		return -1
+	case p.ID > id:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clonePort copies the port so its arrays are not shared with the caller
+func clonePort(p PortEntry) PortEntry {
+	p.Alias = append(p.Alias[:0:0], p.Alias...)
+	p.Regions = append(p.Regions[:0:0], p.Regions...)
+	p.Unlocks = append(p.Unlocks[:0:0], p.Unlocks...)
+
+	return p
+}
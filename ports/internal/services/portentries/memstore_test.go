@@ -0,0 +1,444 @@
+package portentries
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMemStore_BulkUpsert(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	store := NewMemStore()
+
+	_, err := store.BulkUpsert(ctx, []PortEntry{
+		{Slug: "NLRTM", Name: "Rotterdam", Country: "Netherlands"},
+		{Slug: "DEHAM", Name: "Hamburg", Country: "Germany"},
+	}, ConflictOptions{})
+	r.NoError(err)
+
+	results, err := store.BulkUpsert(ctx, []PortEntry{
+		{Slug: "NLRTM", Name: "Rotterdam", City: "Rotterdam", Country: "Netherlands"},
+		{Slug: "DEHAM", Name: "Hamburg", Country: "Germany"},
+	}, ConflictOptions{})
+	r.NoError(err)
+	r.Len(results, 2)
+	r.Equal(UpsertUpdated, results[0].Status)
+	r.Equal(UpsertUnchanged, results[1].Status)
+
+	slug := "NLRTM"
+	port, err := store.Fetch(ctx, nil, &slug)
+	r.NoError(err)
+	r.Equal("Rotterdam", port.City)
+	r.Equal(int64(2), port.Version)
+
+	slug = "DEHAM"
+	port, err = store.Fetch(ctx, nil, &slug)
+	r.NoError(err)
+	r.Equal(int64(1), port.Version)
+
+	all, err := store.List(ctx, ListQuery{Limit: 10})
+	r.NoError(err)
+	r.Len(all, 2)
+
+	changes, err := store.History(ctx, nil, &slug)
+	r.NoError(err)
+	r.Len(changes, 1)
+}
+
+func TestMemStore_BulkUpsertConflicts(t *testing.T) {
+	stored := PortEntry{Slug: "NLRTM", Name: "Rotterdam", Timezone: "Europe/Amsterdam", Latitude: 51.9, Longitude: 4.4}
+	upserted := PortEntry{Slug: "NLRTM", Name: "Port of Rotterdam", City: "Rotterdam"}
+
+	cases := []struct {
+		name      string
+		conflicts ConflictOptions
+		port      PortEntry
+		status    UpsertStatus
+		err       error
+	}{
+		{
+			name:   "overwrite",
+			port:   PortEntry{Slug: "NLRTM", Name: "Port of Rotterdam", City: "Rotterdam"},
+			status: UpsertUpdated,
+		},
+		{
+			name:      "skip existing",
+			conflicts: ConflictOptions{Policy: ConflictSkipExisting},
+			port:      stored,
+			status:    UpsertUnchanged,
+		},
+		{
+			name:      "fill empty only",
+			conflicts: ConflictOptions{Policy: ConflictFillEmptyOnly},
+			port:      PortEntry{Slug: "NLRTM", Name: "Rotterdam", City: "Rotterdam", Timezone: "Europe/Amsterdam", Latitude: 51.9, Longitude: 4.4},
+			status:    UpsertUpdated,
+		},
+		{
+			name:      "field policies",
+			conflicts: ConflictOptions{Fields: FieldPolicies{"timezone": ConflictSkipExisting, "coordinates": ConflictFillEmptyOnly}},
+			port:      PortEntry{Slug: "NLRTM", Name: "Port of Rotterdam", City: "Rotterdam", Timezone: "Europe/Amsterdam", Latitude: 51.9, Longitude: 4.4},
+			status:    UpsertUpdated,
+		},
+		{
+			name:      "fail on conflict",
+			conflicts: ConflictOptions{Policy: ConflictSkipExisting, Fields: FieldPolicies{"name": ConflictFail, "city": ConflictFail}},
+			port:      stored,
+			err: &ConflictError{
+				Conflicts: []PortConflict{{Slug: "NLRTM", Fields: []string{"name", "city"}}},
+				Total:     1,
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			ctx := context.Background()
+			store := NewMemStore()
+
+			_, err := store.Upsert(ctx, stored, ConflictOptions{})
+			r.NoError(err)
+
+			results, err := store.BulkUpsert(ctx, []PortEntry{upserted, {Slug: "DEHAM", Name: "Hamburg"}}, test.conflicts)
+			if test.err != nil {
+				r.Equal(test.err, err)
+
+				all, err := store.List(ctx, ListQuery{Limit: 10})
+				r.NoError(err)
+				r.Len(all, 1)
+				return
+			}
+			r.NoError(err)
+			r.Equal(test.status, results[0].Status)
+			r.Equal(UpsertCreated, results[1].Status)
+
+			port, err := store.Fetch(ctx, nil, &stored.Slug)
+			r.NoError(err)
+			port.ID, port.Version = 0, 0
+			r.Equal(test.port, port)
+		})
+	}
+}
+
+func TestMemStore_Update(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	store := NewMemStore()
+
+	port := PortEntry{Slug: "NLRTM", Name: "Rotterdam"}
+	r.NoError(store.Store(ctx, &port))
+
+	stale := port
+	port.Name = "Port of Rotterdam"
+	r.NoError(store.Update(ctx, &port))
+	r.Equal(int64(2), port.Version)
+
+	stale.Name = "Rotterdam Port"
+	r.Equal(ErrVersionConflict, store.Update(ctx, &stale))
+
+	version := int64(1)
+	r.Equal(ErrVersionConflict, store.Delete(ctx, &port.ID, nil, &version))
+	r.Equal(ErrMissingPortKey, store.Delete(ctx, nil, nil, nil))
+	r.Len(store.ports, 1)
+	r.NoError(store.Delete(ctx, &port.ID, nil, &port.Version))
+	r.Equal(ErrPortNotFound, store.Delete(ctx, &port.ID, nil, nil))
+
+	_, err := store.Fetch(ctx, &port.ID, nil)
+	r.Error(err)
+}
+
+func TestFileStore_Replay(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ports.ndjson")
+
+	store, err := OpenFileStore(path)
+	r.NoError(err)
+
+	_, err = store.BulkUpsert(ctx, []PortEntry{
+		{Slug: "NLRTM", Name: "Rotterdam", Alias: []string{"R'dam"}},
+		{Slug: "DEHAM", Name: "Hamburg"},
+	}, ConflictOptions{})
+	r.NoError(err)
+
+	slug := "DEHAM"
+	r.NoError(store.Delete(ctx, nil, &slug, nil))
+	r.NoError(store.Close())
+
+	store, err = OpenFileStore(path)
+	r.NoError(err)
+	defer func() { _ = store.Close() }()
+
+	all, err := store.List(ctx, ListQuery{Limit: 10})
+	r.NoError(err)
+	r.Len(all, 1)
+	r.Equal("NLRTM", all[0].Slug)
+	r.Equal([]string{"R'dam"}, []string(all[0].Alias))
+
+	port, err := store.Upsert(ctx, PortEntry{Slug: "BEANR", Name: "Antwerp"}, ConflictOptions{})
+	r.NoError(err)
+	r.Equal(int64(3), port.ID)
+}
+
+func TestFileStore_ReplayTruncatedTail(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ports.ndjson")
+
+	store, err := OpenFileStore(path)
+	r.NoError(err)
+	_, err = store.Upsert(ctx, PortEntry{Slug: "NLRTM", Name: "Rotterdam"}, ConflictOptions{})
+	r.NoError(err)
+	r.NoError(store.Close())
+
+	// the crash leaves a half written change behind
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	r.NoError(err)
+	_, err = file.WriteString(`{"port_id":2,"slug":"DEHAM","oper`)
+	r.NoError(err)
+	r.NoError(file.Close())
+
+	store, err = OpenFileStore(path)
+	r.NoError(err)
+	_, err = store.Upsert(ctx, PortEntry{Slug: "BEANR", Name: "Antwerp"}, ConflictOptions{})
+	r.NoError(err)
+	r.NoError(store.Close())
+
+	store, err = OpenFileStore(path)
+	r.NoError(err)
+	defer func() { _ = store.Close() }()
+
+	all, err := store.List(ctx, ListQuery{Limit: 10})
+	r.NoError(err)
+	r.Len(all, 2)
+	r.Equal("NLRTM", all[0].Slug)
+	r.Equal("BEANR", all[1].Slug)
+}
+
+// failingFile writes up to limit bytes to the journal file and fails after them, or fails the sync
+type failingFile struct {
+	*os.File
+	limit    int
+	failSync bool
+}
+
+func (f *failingFile) Write(p []byte) (int, error) {
+	if len(p) <= f.limit {
+		f.limit -= len(p)
+		return f.File.Write(p)
+	}
+
+	n, _ := f.File.Write(p[:f.limit])
+	f.limit = 0
+
+	return n, errors.New("no space left on device")
+}
+
+func (f *failingFile) Sync() error {
+	if f.failSync {
+		return errors.New("input/output error")
+	}
+
+	return f.File.Sync()
+}
+
+func TestFileStore_AppendFails(t *testing.T) {
+	cases := []struct {
+		name string
+		file func(file *os.File) *failingFile
+	}{
+		{
+			name: "write cut short",
+			file: func(file *os.File) *failingFile { return &failingFile{File: file, limit: 20} },
+		},
+		{
+			name: "sync fails",
+			file: func(file *os.File) *failingFile { return &failingFile{File: file, limit: 1 << 20, failSync: true} },
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			ctx := context.Background()
+			path := filepath.Join(t.TempDir(), "ports.ndjson")
+
+			store, err := OpenFileStore(path)
+			r.NoError(err)
+			_, err = store.Upsert(ctx, PortEntry{Slug: "NLRTM", Name: "Rotterdam"}, ConflictOptions{})
+			r.NoError(err)
+
+			before, err := ioutil.ReadFile(path)
+			r.NoError(err)
+
+			file := store.file.(*os.File)
+			store.file = test.file(file)
+			_, err = store.BulkUpsert(ctx, []PortEntry{
+				{Slug: "DEHAM", Name: "Hamburg"},
+				{Slug: "BEANR", Name: "Antwerp"},
+			}, ConflictOptions{})
+			r.Error(err)
+
+			after, err := ioutil.ReadFile(path)
+			r.NoError(err)
+			r.Equal(string(before), string(after))
+
+			slug := "DEHAM"
+			_, err = store.Fetch(ctx, nil, &slug)
+			r.Equal(ErrPortNotFound, err)
+
+			store.file = file
+			_, err = store.Upsert(ctx, PortEntry{Slug: "USNYC", Name: "New York"}, ConflictOptions{})
+			r.NoError(err)
+			r.NoError(store.Close())
+
+			store, err = OpenFileStore(path)
+			r.NoError(err)
+			defer func() { _ = store.Close() }()
+
+			all, err := store.List(ctx, ListQuery{Limit: 10})
+			r.NoError(err)
+			r.Len(all, 2)
+			r.Equal("NLRTM", all[0].Slug)
+			r.Equal("USNYC", all[1].Slug)
+		})
+	}
+}
+
+func TestFileStore_ReplayCorrupted(t *testing.T) {
+	r := require.New(t)
+	path := filepath.Join(t.TempDir(), "ports.ndjson")
+	r.NoError(ioutil.WriteFile(path, []byte("{\"slug\":\n{}\n"), 0644))
+
+	_, err := OpenFileStore(path)
+	r.Error(err)
+}
+
+func TestMemStore_FinishImport(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	store := NewMemStore()
+
+	_, err := store.BulkUpsert(ctx, []PortEntry{
+		{Slug: "NLRTM", Name: "Rotterdam"},
+		{Slug: "DEHAM", Name: "Hamburg"},
+		{Slug: "BEANR", Name: "Antwerp"},
+	}, ConflictOptions{})
+	r.NoError(err)
+
+	staged := []PortEntry{
+		{Slug: "NLRTM", Name: "Rotterdam"},
+		{Slug: "DEHAM", Name: "Port of Hamburg"},
+		{Slug: "PLGDN", Name: "Gdansk"},
+	}
+
+	cases := []struct {
+		name    string
+		opts    ImportOptions
+		summary ImportSummary
+		err     error
+		ports   int
+	}{
+		{
+			name:    "dry run",
+			opts:    ImportOptions{Mode: ImportSync, DryRun: true},
+			summary: ImportSummary{Created: 1, Updated: 1, Unchanged: 1, Removed: 1},
+			ports:   3,
+		},
+		{
+			name:  "fail on conflict",
+			opts:  ImportOptions{Mode: ImportSync, Conflicts: ConflictOptions{Policy: ConflictFail}},
+			err:   &ConflictError{Conflicts: []PortConflict{{Slug: "DEHAM", Fields: []string{"name"}}}, Total: 1},
+			ports: 3,
+		},
+		{
+			name:    "sync",
+			opts:    ImportOptions{Mode: ImportSync},
+			summary: ImportSummary{Created: 1, Updated: 1, Unchanged: 1, Removed: 1},
+			ports:   3,
+		},
+		{
+			name:    "upsert after sync",
+			opts:    ImportOptions{Mode: ImportUpsert},
+			summary: ImportSummary{Unchanged: 3},
+			ports:   3,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			id, err := store.StartImport(ctx, LoaderInsert)
+			r.NoError(err)
+			r.NoError(store.StageImport(ctx, id, staged))
+
+			summary, err := store.FinishImport(ctx, id, test.opts)
+			if test.err != nil {
+				r.Equal(test.err, err)
+				r.NoError(store.AbortImport(ctx, id))
+			} else {
+				r.NoError(err)
+				r.Equal(test.summary, summary)
+			}
+
+			ports, err := store.List(ctx, ListQuery{Limit: 10})
+			r.NoError(err)
+			r.Len(ports, test.ports)
+
+			_, err = store.FinishImport(ctx, id, test.opts)
+			r.Equal(ErrImportNotFound, err)
+		})
+	}
+
+	slug := "BEANR"
+	_, err = store.Fetch(ctx, nil, &slug)
+	r.Error(err)
+}
+
+func TestMemStore_UpdateImportJob(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	store := NewMemStore()
+
+	job := ImportJob{Mode: ImportSync, FileName: "ports.json"}
+	r.NoError(store.CreateImportJob(ctx, &job))
+	r.Equal(ImportJobPending, job.Status)
+
+	job, err := store.UpdateImportJob(ctx, ImportJobUpdate{
+		ID:        job.ID,
+		Status:    ImportJobRunning,
+		ImportID:  "first",
+		Processed: 20,
+		Failed:    1,
+		Errors:    []string{"NLRTM: bad coordinates"},
+	})
+	r.NoError(err)
+	r.Len(job.Errors, 1)
+
+	// restarted job starts over with a new import
+	job, err = store.UpdateImportJob(ctx, ImportJobUpdate{ID: job.ID, Status: ImportJobRunning, ImportID: "second"})
+	r.NoError(err)
+	r.Empty(job.Errors)
+	r.Equal(int64(0), job.Processed)
+
+	job, err = store.UpdateImportJob(ctx, ImportJobUpdate{
+		ID:        job.ID,
+		Status:    ImportJobSucceeded,
+		Processed: 40,
+		Summary:   &ImportSummary{Created: 40},
+	})
+	r.NoError(err)
+	r.NotNil(job.FinishedAt)
+	r.Equal(int64(40), job.Summary.Created)
+
+	_, err = store.UpdateImportJob(ctx, ImportJobUpdate{ID: job.ID, Status: ImportJobFailed})
+	r.Equal(ErrImportJobFinished, err)
+
+	jobs, err := store.ListImportJobs(ctx, ImportJobsQuery{Limit: 10, Statuses: []string{ImportJobPending, ImportJobRunning}})
+	r.NoError(err)
+	r.Empty(jobs)
+}
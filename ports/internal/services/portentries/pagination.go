@@ -0,0 +1,135 @@
+package portentries
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultPageSize is used when ListPortsRequest has no page size, the upper limit is enforced by validation rules
+const defaultPageSize = 100
+
+// exportBatchSize is the number of ports ExportPorts fetches and sends at once
+const exportBatchSize = 500
+
+var (
+	errInvalidPageToken  = errors.New("invalid page token")
+	errPageTokenMismatch = errors.New("page token does not match requested filters or sort order")
+)
+
+// pageToken is the opaque cursor handed to the clients between ListPorts calls
+type pageToken struct {
+	OrderBy    string `json:"o,omitempty"`
+	Descending bool   `json:"d,omitempty"`
+	Value      string `json:"v,omitempty"`
+	ID         int64  `json:"i"`
+	// Filters is the hash of the filters the token was issued for, the next pages are listed with the same ones
+	Filters string `json:"f,omitempty"`
+}
+
+// jobsOrderBy marks the page tokens of ListImportJobs, jobs are always listed newest first
+const jobsOrderBy = "jobs"
+
+// encodePageToken builds the token pointing right after the given port
+func encodePageToken(query ListQuery, last PortEntry) string {
+	return pageToken{
+		OrderBy:    query.OrderBy,
+		Descending: query.Descending,
+		Value:      sortValue(query.OrderBy, last),
+		ID:         last.ID,
+		Filters:    filtersHash(query),
+	}.encode()
+}
+
+// decodePageToken parses the token and checks it was issued for the same filters and sort order
+func decodePageToken(raw string, query ListQuery) (*Cursor, error) {
+	token, err := parsePageToken(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.OrderBy != query.OrderBy || token.Descending != query.Descending || token.Filters != filtersHash(query) {
+		return nil, errPageTokenMismatch
+	}
+
+	return &Cursor{Value: token.Value, ID: token.ID}, nil
+}
+
+// encodeJobsPageToken builds the token pointing right after the given import job
+func encodeJobsPageToken(last ImportJob) string {
+	return pageToken{OrderBy: jobsOrderBy, Descending: true, ID: last.ID}.encode()
+}
+
+// decodeJobsPageToken parses the token of ListImportJobs and returns id of the last listed job
+func decodeJobsPageToken(raw string) (int64, error) {
+	token, err := parsePageToken(raw)
+	if err != nil {
+		return 0, err
+	}
+
+	if token.OrderBy != jobsOrderBy {
+		return 0, errInvalidPageToken
+	}
+
+	return token.ID, nil
+}
+
+func (t pageToken) encode() string {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+func parsePageToken(raw string) (pageToken, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return pageToken{}, errInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(bytes, &token); err != nil {
+		return pageToken{}, errInvalidPageToken
+	}
+
+	return token, nil
+}
+
+// filtersHash is the short hash of the filters of the query, empty when there are none
+func filtersHash(query ListQuery) string {
+	filters := []string{query.Country, query.Province, query.Region, query.Timezone, query.CodePrefix, ""}
+	if query.AsOf != nil {
+		filters[len(filters)-1] = query.AsOf.UTC().Format(time.RFC3339Nano)
+	}
+
+	joined := strings.Join(filters, "\x00")
+	if strings.Trim(joined, "\x00") == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(joined))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+func sortValue(orderBy string, port PortEntry) string {
+	switch orderBy {
+	case "slug":
+		return port.Slug
+	case "name":
+		return port.Name
+	case "city":
+		return port.City
+	case "country":
+		return port.Country
+	default:
+		return strconv.FormatInt(port.ID, 10)
+	}
+}
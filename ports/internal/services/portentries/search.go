@@ -0,0 +1,126 @@
+package portentries
+
+import (
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode"
+)
+
+const (
+	// defaultSearchLimit is the number of ports returned when the request has no limit
+	defaultSearchLimit = 20
+	// searchThreshold is the minimal trigram word similarity of a port to be matched
+	searchThreshold = 0.4
+)
+
+// searchScoreSQL ranks a port by its best matching field, name matches are weighted the most
+// and exact code, unlocks or slug matches always get the top score
+// it expects normalized query four times and the uppercased query three times as query args
+const searchScoreSQL = `greatest(
+	word_similarity(?, ports.normalize_text(coalesce(name, ''))),
+	word_similarity(?, ports.normalize_text(coalesce(array_to_string(alias, ' '), ''))) * 0.95,
+	word_similarity(?, ports.normalize_text(coalesce(city, ''))) * 0.9,
+	word_similarity(?, ports.normalize_text(concat_ws(' ', code, array_to_string(unlocks, ' ')))) * 0.9,
+	CASE WHEN upper(code) = ? OR ? = ANY(unlocks) OR slug = ? THEN 1 ELSE 0 END
+)`
+
+// searchDocumentSQL must match the expression of port_entries_search_idx index
+const searchDocumentSQL = "ports.port_search_document(name, city, alias, code, unlocks)"
+
+// SearchQuery describes the fuzzy ports search
+type SearchQuery struct {
+	Text  string
+	Limit int
+}
+
+// PortMatch is a port found by the search with its relevance score
+type PortMatch struct {
+	PortEntry
+	Score float64 `gorm:"column:score"`
+}
+
+// normalizeSearchText prepares user input for the search, apostrophes are dropped so "R'dam" becomes "rdam"
+// and every other punctuation separates words, diacritics are stripped by the db itself
+func normalizeSearchText(text string) string {
+	text = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\'' || r == '’' || r == '`':
+			return -1
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		default:
+			return ' '
+		}
+	}, text)
+
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// foldText lowers the text and strips diacritics the same way ports.normalize_text db function does
+func foldText(text string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, text)
+	if err != nil {
+		folded = text
+	}
+
+	return strings.ToLower(folded)
+}
+
+// trigrams splits the words of the text into ordered pg_trgm alike trigrams,
+// every word is padded with two spaces in front and one at the end
+func trigrams(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var res []string
+	for _, w := range words {
+		padded := []rune("  " + w + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			res = append(res, string(padded[i:i+3]))
+		}
+	}
+
+	return res
+}
+
+// wordSimilarity approximates pg_trgm word_similarity, it is the best similarity between the query trigrams
+// and any continuous extent of the text trigrams
+func wordSimilarity(query, text string) float64 {
+	queryTrgm := map[string]struct{}{}
+	for _, t := range trigrams(query) {
+		queryTrgm[t] = struct{}{}
+	}
+	if len(queryTrgm) == 0 {
+		return 0
+	}
+
+	textTrgm := trigrams(text)
+	maxExtent := 2 * len(queryTrgm)
+
+	var best float64
+	for i := range textTrgm {
+		extent := map[string]struct{}{}
+		shared := 0
+		for j := i; j < len(textTrgm) && j-i < maxExtent; j++ {
+			if _, ok := extent[textTrgm[j]]; ok {
+				continue
+			}
+			extent[textTrgm[j]] = struct{}{}
+
+			if _, ok := queryTrgm[textTrgm[j]]; ok {
+				shared++
+			}
+
+			sim := float64(shared) / float64(len(queryTrgm)+len(extent)-shared)
+			if sim > best {
+				best = sim
+			}
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,61 @@
+package portentries
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNormalizeSearchText(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected string
+	}{
+		{text: "Rotterdam", expected: "rotterdam"},
+		{text: "  Port   of\tRotterdam\n", expected: "port of rotterdam"},
+		{text: "R'dam", expected: "rdam"},
+		{text: "R’dam", expected: "rdam"},
+		{text: "St.-Petersburg", expected: "st petersburg"},
+		{text: "NL/RTM,BE-ANR", expected: "nl rtm be anr"},
+		{text: "São Paulo", expected: "são paulo"},
+		{text: " Gdańsk ", expected: "gdańsk"},
+		{text: "?!", expected: ""},
+		{text: "", expected: ""},
+	}
+
+	for _, test := range cases {
+		t.Run(test.text, func(t *testing.T) {
+			require.Equal(t, test.expected, normalizeSearchText(test.text))
+		})
+	}
+}
+
+func TestFoldText(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected string
+	}{
+		{text: "Rotterdam", expected: "rotterdam"},
+		{text: "São Paulo", expected: "sao paulo"},
+		{text: "GDAŃSK", expected: "gdansk"},
+		{text: "Curaçao", expected: "curacao"},
+		{text: "Ålesund", expected: "alesund"},
+		// decomposed input folds the same as the composed one
+		{text: "Sa\u0303o Paulo", expected: "sao paulo"},
+	}
+
+	for _, test := range cases {
+		t.Run(test.text, func(t *testing.T) {
+			require.Equal(t, test.expected, foldText(test.text))
+		})
+	}
+}
+
+func TestWordSimilarity(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(1.0, wordSimilarity("rotterdam", "port of rotterdam"))
+	r.Equal(0.0, wordSimilarity("", "rotterdam"))
+	r.Equal(0.0, wordSimilarity("xyz", "rotterdam"))
+	r.Greater(wordSimilarity("roterdam", "rotterdam"), 0.5)
+	r.Greater(wordSimilarity("rotterdam", "rotterdam"), wordSimilarity("roterdam", "rotterdam"))
+}
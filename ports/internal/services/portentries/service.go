@@ -2,10 +2,15 @@ package portentries
 
 import (
 	"context"
+	"errors"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/kreyyser/transshipment/common/actor"
 	pb "github.com/kreyyser/transshipment/pb/portentries"
 	"google.golang.org/grpc"
-	"gorm.io/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
 )
 
 var _ interface {
@@ -14,15 +19,16 @@ var _ interface {
 
 // Service encapsulates ports operations
 type Service struct {
-	db    *gorm.DB
-	store PortsDB
+	store  PortsDB
+	domain *DomainValidator
 }
 
-// NewPortsService returns pointer to newly created Ports service
-func NewPortsService(db *gorm.DB) *Service {
+// NewPortsService returns pointer to newly created Ports service backed by the given datastore,
+// the ports are checked with the domain rules of the validator unless it is nil
+func NewPortsService(store PortsDB, domain *DomainValidator) *Service {
 	return &Service{
-		db:    db,
-		store: NewDatastore(db),
+		store:  store,
+		domain: domain,
 	}
 }
 
@@ -33,154 +39,894 @@ func (s *Service) GRPCRegister(srvr *grpc.Server) {
 
 // CreateOrUpdatePort creates port based on request or updates it
 func (s Service) CreateOrUpdatePort(ctx context.Context, req *pb.CreatePortRequest) (*pb.EmptyResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	conflicts, err := conflictsFromPB(req.Conflicts)
+	if err != nil {
+		return nil, statusError(err)
 	}
 
-	if _, err := s.store.Upsert(ctx, pbPortToModel(req.Data)); err != nil {
+	port := pbPortToModel(req.Data)
+	if _, err := checkPort(s.domain, port, "data."); err != nil {
 		return nil, err
 	}
 
+	if _, err := s.store.Upsert(ctx, port, conflicts); err != nil {
+		return nil, statusError(err)
+	}
+
 	return &pb.EmptyResponse{}, nil
 }
 
 // CreateOrUpdatePortBulk creates ports based on request or updates them
-func (s Service) CreateOrUpdatePortBulk(ctx context.Context, req *pb.UpsertPortBulkRequest) (*pb.EmptyResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
+// every port is validated on its own and gets its result, with continue_on_error the valid ports are stored
+// even when other ports have failed, otherwise nothing is stored and the valid ports are skipped
+func (s Service) CreateOrUpdatePortBulk(ctx context.Context, req *pb.UpsertPortBulkRequest) (*pb.UpsertPortBulkResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
 	}
 
-	if _, err := s.store.BulkUpsert(ctx, pbToPorts(req.Data)); err != nil {
-		return nil, err
+	conflicts, err := conflictsFromPB(req.Conflicts)
+	if err != nil {
+		return nil, statusError(err)
 	}
 
-	return &pb.EmptyResponse{}, nil
+	valid, indexes, failed, warned := validatePorts(req.Data, true, s.domain)
+
+	res := &pb.UpsertPortBulkResponse{
+		Results: make([]*pb.PortResult, len(req.Data)),
+		Failed:  int64(len(failed)),
+	}
+	for _, result := range failed {
+		res.Results[result.Index] = result
+	}
+
+	if len(failed) > 0 && !req.ContinueOnError {
+		for j, i := range indexes {
+			res.Results[i] = &pb.PortResult{Index: int32(i), Slug: valid[j].Slug, Status: pb.PortResult_SKIPPED}
+		}
+		addWarnings(res.Results, warned)
+
+		return res, nil
+	}
+
+	var stored []UpsertResult
+	for len(valid) > 0 {
+		var err error
+		stored, err = s.store.BulkUpsert(ctx, valid, conflicts)
+
+		var conflict *ConflictError
+		if !errors.As(err, &conflict) {
+			if err != nil {
+				return nil, statusError(err)
+			}
+			break
+		}
+
+		// the ports failing on conflict are reported as failed, the rest is upserted again without them
+		valid, indexes = failConflicts(res, conflict, valid, indexes)
+		if !req.ContinueOnError {
+			for j, i := range indexes {
+				res.Results[i] = &pb.PortResult{Index: int32(i), Slug: valid[j].Slug, Status: pb.PortResult_SKIPPED}
+			}
+			addWarnings(res.Results, warned)
+
+			return res, nil
+		}
+	}
+
+	for j, port := range stored {
+		result := &pb.PortResult{
+			Index:   int32(indexes[j]),
+			Slug:    port.Slug,
+			Id:      port.ID,
+			Version: port.Version,
+		}
+
+		switch port.Status {
+		case UpsertCreated:
+			result.Status = pb.PortResult_CREATED
+			res.Created++
+		case UpsertUpdated:
+			result.Status = pb.PortResult_UPDATED
+			res.Updated++
+		default:
+			result.Status = pb.PortResult_UNCHANGED
+			res.Unchanged++
+		}
+
+		res.Results[indexes[j]] = result
+	}
+	addWarnings(res.Results, warned)
+
+	return res, nil
+}
+
+// StreamUpsertPorts stages the ports as they are received and applies them in a single transaction
+// once the client closes the stream, in sync mode nothing is applied when any port has failed
+func (s Service) StreamUpsertPorts(stream pb.PortsService_StreamUpsertPortsServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return stream.SendAndClose(&pb.StreamUpsertPortsResponse{})
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return statusError(err)
+	}
+
+	conflicts, err := conflictsFromPB(req.Conflicts)
+	if err != nil {
+		return statusError(err)
+	}
+
+	opts := ImportOptions{Mode: importModeFromPB(req.Mode), DryRun: req.DryRun, Conflicts: conflicts}
+	loader := importLoaderFromPB(req.Loader)
+
+	batchSize := streamBatchSize
+	if loader == LoaderCopy {
+		batchSize = copyBatchSize
+	}
+
+	importID, err := s.store.StartImport(ctx, loader)
+	if err != nil {
+		return statusError(err)
+	}
+
+	abort := func(err error) error {
+		// the stream may be gone already, the import is dropped anyway
+		_ = s.store.AbortImport(context.Background(), importID)
+
+		return statusError(err)
+	}
+
+	res := &pb.StreamUpsertPortsResponse{DryRun: opts.DryRun}
+	batch := make([]PortEntry, 0, batchSize)
+	for {
+		valid, _, failed, warned := validatePorts(req.Data, false, s.domain)
+		for _, result := range failed {
+			result.Index += int32(res.Received)
+			if len(res.FailedPorts) < maxStreamFailures {
+				res.FailedPorts = append(res.FailedPorts, result)
+			}
+		}
+		for _, result := range warned {
+			result.Index += int32(res.Received)
+			if len(res.WarnedPorts) < maxStreamFailures {
+				res.WarnedPorts = append(res.WarnedPorts, result)
+			}
+		}
+		res.Received += int64(len(req.Data))
+		res.Failed += int64(len(failed))
+		res.Warned += int64(len(warned))
+
+		batch = append(batch, valid...)
+		if len(batch) >= batchSize {
+			if err := s.store.StageImport(ctx, importID, batch); err != nil {
+				return abort(err)
+			}
+			batch = batch[:0]
+		}
+
+		req, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return abort(err)
+		}
+
+		if err := req.ValidateAll(); err != nil {
+			return abort(err)
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := s.store.StageImport(ctx, importID, batch); err != nil {
+			return abort(err)
+		}
+	}
+
+	if opts.Mode == ImportSync && res.Failed > 0 {
+		// nothing is applied, the staged ports are dropped before the summary is sent
+		_ = s.store.AbortImport(context.Background(), importID)
+		res.Aborted = true
+
+		if err := stream.SendAndClose(res); err != nil {
+			return statusError(err)
+		}
+
+		return nil
+	}
+
+	summary, err := s.store.FinishImport(ctx, importID, opts)
+	if err != nil {
+		return abort(err)
+	}
+
+	res.Created = summary.Created
+	res.Updated = summary.Updated
+	res.Unchanged = summary.Unchanged
+	res.Removed = summary.Removed
+
+	return stream.SendAndClose(res)
 }
 
 // ListPorts returns list of ports
 func (s Service) ListPorts(ctx context.Context, req *pb.ListPortsRequest) (*pb.ListPortsResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	query := ListQuery{
+		Limit:      int(req.PageSize),
+		Country:    req.Country,
+		Province:   req.Province,
+		Region:     req.Region,
+		Timezone:   req.Timezone,
+		CodePrefix: req.CodePrefix,
+		OrderBy:    req.OrderBy,
+		Descending: req.Descending,
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultPageSize
+	}
+
+	if req.AsOf != nil {
+		asOf, err := ptypes.Timestamp(req.AsOf)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of: %s", err)
+		}
+		query.AsOf = &asOf
+	}
+
+	if req.PageToken != "" {
+		cursor, err := decodePageToken(req.PageToken, query)
+		if err != nil {
+			return nil, statusError(err)
+		}
+		query.After = cursor
 	}
 
-	ports, err := s.store.List(ctx)
+	// fetch one extra port to find out whether there is a next page
+	pageSize := query.Limit
+	query.Limit++
+	ports, err := s.store.List(ctx, query)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
+	}
+
+	res := &pb.ListPortsResponse{}
+	if len(ports) > pageSize {
+		ports = ports[:pageSize]
+		res.NextPageToken = encodePageToken(query, ports[len(ports)-1])
+	}
+	res.Data = portsToPB(ports)
+
+	return res, nil
+}
+
+// ExportPorts streams every port matching the filters, the ports are fetched page by page
+// so the memory stays the same however many ports there are
+func (s Service) ExportPorts(req *pb.ExportPortsRequest, stream pb.PortsService_ExportPortsServer) error {
+	if err := req.ValidateAll(); err != nil {
+		return statusError(err)
+	}
+
+	query := ListQuery{
+		Limit:      exportBatchSize,
+		Country:    req.Country,
+		Province:   req.Province,
+		Region:     req.Region,
+		Timezone:   req.Timezone,
+		CodePrefix: req.CodePrefix,
+		OrderBy:    req.OrderBy,
+		Descending: req.Descending,
+	}
+
+	if req.AsOf != nil {
+		asOf, err := ptypes.Timestamp(req.AsOf)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid as_of: %s", err)
+		}
+		query.AsOf = &asOf
 	}
 
-	return &pb.ListPortsResponse{Data: portsToPB(ports)}, nil
+	for {
+		ports, err := s.store.List(stream.Context(), query)
+		if err != nil {
+			return statusError(err)
+		}
+
+		if len(ports) == 0 {
+			return nil
+		}
+
+		if err := stream.Send(&pb.ExportPortsResponse{Data: portsToPB(ports)}); err != nil {
+			return err
+		}
+
+		if len(ports) < exportBatchSize {
+			return nil
+		}
+
+		last := ports[len(ports)-1]
+		query.After = &Cursor{Value: sortValue(query.OrderBy, last), ID: last.ID}
+	}
 }
 
 // FetchPort returns ports by id or slug
 func (s Service) FetchPort(ctx context.Context, req *pb.PortRequest) (*pb.PortResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
 	}
 
-	id, slug := extractIdOrSlug(req)
-	port, err := s.store.Fetch(ctx, id, slug)
+	id, slug, err := extractIdOrSlug(req)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
+	}
+
+	var port PortEntry
+	if req.AsOf != nil {
+		asOf, tsErr := ptypes.Timestamp(req.AsOf)
+		if tsErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of: %s", tsErr)
+		}
+		port, err = s.store.FetchAsOf(ctx, id, slug, asOf)
+	} else {
+		port, err = s.store.Fetch(ctx, id, slug)
+	}
+	if err != nil {
+		return nil, statusError(err)
 	}
 
 	return &pb.PortResponse{Data: portToPB(port)}, nil
 }
 
+// BatchGetPorts fetches the ports by their ids, slugs or unlocks at once, the ports come in the order of the keys
+// and the keys matching no port are reported as not found, the unlock shared by several ports finds all of them
+// the blank or malformed keys match no port, so they are reported as not found as well
+func (s Service) BatchGetPorts(ctx context.Context, req *pb.BatchGetPortsRequest) (*pb.BatchGetPortsResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	var keys PortKeys
+	for _, key := range req.Keys {
+		if key.ValidateAll() != nil {
+			continue
+		}
+
+		switch k := key.GetKey().(type) {
+		case *pb.PortKey_Id:
+			keys.IDs = append(keys.IDs, k.Id)
+		case *pb.PortKey_Slug:
+			keys.Slugs = append(keys.Slugs, k.Slug)
+		case *pb.PortKey_Unlock:
+			keys.Unlocks = append(keys.Unlocks, k.Unlock)
+		}
+	}
+
+	ports, err := s.store.BatchFetch(ctx, keys)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	var (
+		byID     = make(map[int64]PortEntry, len(ports))
+		bySlug   = make(map[string][]PortEntry, len(ports))
+		byUnlock = make(map[string][]PortEntry, len(ports))
+	)
+	for _, port := range ports {
+		byID[port.ID] = port
+		bySlug[port.Slug] = append(bySlug[port.Slug], port)
+		for _, unlock := range port.Unlocks {
+			byUnlock[unlock] = append(byUnlock[unlock], port)
+		}
+	}
+
+	res := &pb.BatchGetPortsResponse{}
+	listed := make(map[int64]bool, len(ports))
+	for _, key := range req.Keys {
+		if key == nil {
+			key = &pb.PortKey{}
+		}
+
+		var found []PortEntry
+		if key.ValidateAll() != nil {
+			res.NotFound = append(res.NotFound, key)
+			continue
+		}
+
+		switch k := key.Key.(type) {
+		case *pb.PortKey_Id:
+			if port, ok := byID[k.Id]; ok {
+				found = []PortEntry{port}
+			}
+		case *pb.PortKey_Slug:
+			found = bySlug[k.Slug]
+		case *pb.PortKey_Unlock:
+			found = byUnlock[k.Unlock]
+		}
+
+		if len(found) == 0 {
+			res.NotFound = append(res.NotFound, key)
+			continue
+		}
+
+		for _, port := range found {
+			if !listed[port.ID] {
+				listed[port.ID] = true
+				res.Data = append(res.Data, portToPB(port))
+			}
+		}
+	}
+
+	return res, nil
+}
+
 // CreatePort creates new port
 func (s Service) CreatePort(ctx context.Context, req *pb.CreatePortRequest) (*pb.PortResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
 	}
 
 	port := pbPortToModel(req.Data)
-	if err := s.store.Store(ctx, &port); err != nil {
+	warnings, err := checkPort(s.domain, port, "data.")
+	if err != nil {
 		return nil, err
 	}
 
-	return &pb.PortResponse{Data: portToPB(port)}, nil
+	if err := s.store.Store(ctx, &port); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.PortResponse{Data: portToPB(port), Warnings: warnings}, nil
 }
 
-// UpdatePort updates port by id or slug
+// UpdatePort updates port by id or slug, either the fields listed in the update mask or the fields set in data
 func (s Service) UpdatePort(ctx context.Context, req *pb.UpdatePortRequest) (*pb.PortResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	id, slug, err := extractIdOrSlug(req)
+	if err != nil {
+		return nil, statusError(err)
 	}
 
-	id, slug := extractIdOrSlug(req)
 	port, err := s.store.Fetch(ctx, id, slug)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	if req.ExpectedVersion != nil && req.ExpectedVersion.Value != port.Version {
+		return nil, versionConflictError(port.Version)
+	}
+
+	if req.UpdateMask != nil {
+		if err := applyUpdateMask(&port, req.Data, req.UpdateMask.Paths); err != nil {
+			return nil, statusError(err)
+		}
+	} else {
+		applyUpdatable(&port, req.Data)
+	}
+
+	warnings, err := checkPort(s.domain, port, "data.")
 	if err != nil {
 		return nil, err
 	}
 
-	if req.Data.Code != nil {
-		port.Code = req.Data.Code.Value
+	if err := s.store.Update(ctx, &port); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.PortResponse{Data: portToPB(port), Warnings: warnings}, nil
+}
+
+// DeletePort deletes port by id or slug
+func (s Service) DeletePort(ctx context.Context, req *pb.PortRequest) (*pb.EmptyResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	id, slug, err := extractIdOrSlug(req)
+	if err != nil {
+		return nil, statusError(err)
 	}
 
-	if req.Data.Unlocks != nil {
-		port.Unlocks = req.Data.Unlocks
+	var version *int64
+	if req.ExpectedVersion != nil {
+		version = &req.ExpectedVersion.Value
 	}
 
-	if req.Data.Timezone != nil {
-		port.Timezone = req.Data.Timezone.Value
+	if err := s.store.Delete(ctx, id, slug, version); err != nil {
+		return nil, statusError(err)
 	}
 
-	if req.Data.Coordinates != nil {
-		port.Longitude = req.Data.Coordinates.Lng
-		port.Latitude = req.Data.Coordinates.Lat
+	return &pb.EmptyResponse{}, nil
+}
+
+// FindNearestPorts returns ports closest to the location ordered by great-circle distance
+func (s Service) FindNearestPorts(ctx context.Context, req *pb.NearestPortsRequest) (*pb.NearestPortsResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
 	}
 
-	if req.Data.Regions != nil {
-		port.Regions = req.Data.Regions
+	query := NearestQuery{
+		Latitude:  req.Location.Lat,
+		Longitude: req.Location.Lng,
+		RadiusKm:  req.RadiusKm,
+		Limit:     int(req.Limit),
+		Country:   req.Country,
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultNearestLimit
 	}
 
-	if req.Data.Alias != nil {
-		port.Alias = req.Data.Alias
+	ports, err := s.store.Nearest(ctx, query)
+	if err != nil {
+		return nil, statusError(err)
 	}
 
-	if req.Data.Country != nil {
-		port.Country = req.Data.Country.Value
+	data := make([]*pb.PortDistance, 0, len(ports))
+	for _, v := range ports {
+		data = append(data, &pb.PortDistance{
+			Port:       portToPB(v.PortEntry),
+			DistanceKm: v.DistanceKm,
+		})
 	}
 
-	if req.Data.Province != nil {
-		port.Province = req.Data.Province.Value
+	return &pb.NearestPortsResponse{Data: data}, nil
+}
+
+// SearchPorts returns ports fuzzy matching the query by name, city, alias, code or unlocks
+func (s Service) SearchPorts(ctx context.Context, req *pb.SearchPortsRequest) (*pb.SearchPortsResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
 	}
 
-	if req.Data.City != nil {
-		port.City = req.Data.City.Value
+	query := SearchQuery{
+		Text:  req.Q,
+		Limit: int(req.Limit),
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultSearchLimit
 	}
 
-	if req.Data.Name != nil {
-		port.Name = req.Data.Name.Value
+	ports, err := s.store.Search(ctx, query)
+	if err != nil {
+		return nil, statusError(err)
 	}
 
-	if err := s.store.Update(ctx, &port); err != nil {
-		return nil, err
+	data := make([]*pb.PortMatch, 0, len(ports))
+	for _, v := range ports {
+		data = append(data, &pb.PortMatch{
+			Port:  portToPB(v.PortEntry),
+			Score: v.Score,
+		})
 	}
 
-	return &pb.PortResponse{Data: portToPB(port)}, nil
+	return &pb.SearchPortsResponse{Data: data}, nil
 }
 
-// DeletePort deletes port by id or slug
-func (s Service) DeletePort(ctx context.Context, req *pb.PortRequest) (*pb.EmptyResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
+// GetPortHistory returns every change of the port by id or slug ordered by version
+func (s Service) GetPortHistory(ctx context.Context, req *pb.PortHistoryRequest) (*pb.PortHistoryResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
 	}
 
-	id, slug := extractIdOrSlug(req)
+	id, slug, err := extractIdOrSlug(req)
+	if err != nil {
+		return nil, statusError(err)
+	}
 
-	if err := s.store.Delete(ctx, id, slug); err != nil {
-		return nil, err
+	changes, err := s.store.History(ctx, id, slug)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	data := make([]*pb.PortChange, 0, len(changes))
+	for _, v := range changes {
+		change, err := portChangeToPB(v)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, change)
+	}
+
+	return &pb.PortHistoryResponse{Data: data}, nil
+}
+
+// StartPortsImport opens a new import
+func (s Service) StartPortsImport(ctx context.Context, req *pb.StartPortsImportRequest) (*pb.PortsImportResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	id, err := s.store.StartImport(ctx, importLoaderFromPB(req.Loader))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.PortsImportResponse{ImportId: id}, nil
+}
+
+// StagePortsImport adds the ports to the import, they are not visible until the import is finished
+// invalid ports are not staged, they are returned as failed instead
+func (s Service) StagePortsImport(ctx context.Context, req *pb.StagePortsImportRequest) (*pb.StagePortsImportResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	valid, _, failed, warned := validatePorts(req.Data, false, s.domain)
+	if len(valid) > 0 {
+		if err := s.store.StageImport(ctx, req.ImportId, valid); err != nil {
+			return nil, statusError(err)
+		}
+	}
+
+	return &pb.StagePortsImportResponse{Failed: failed, Warned: warned}, nil
+}
+
+// FinishPortsImport applies every staged port of the import at once,
+// sync mode also deletes the ports missing from the import and dry run only counts the changes
+func (s Service) FinishPortsImport(ctx context.Context, req *pb.FinishPortsImportRequest) (*pb.PortsImportSummary, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	conflicts, err := conflictsFromPB(req.Conflicts)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	opts := ImportOptions{Mode: importModeFromPB(req.Mode), DryRun: req.DryRun, Conflicts: conflicts}
+
+	summary, err := s.store.FinishImport(ctx, req.ImportId, opts)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.PortsImportSummary{
+		Created:   summary.Created,
+		Updated:   summary.Updated,
+		Unchanged: summary.Unchanged,
+		Removed:   summary.Removed,
+		DryRun:    req.DryRun,
+	}, nil
+}
+
+// AbortPortsImport drops the import with its staged ports
+func (s Service) AbortPortsImport(ctx context.Context, req *pb.PortsImportRequest) (*pb.EmptyResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	if err := s.store.AbortImport(ctx, req.ImportId); err != nil {
+		return nil, statusError(err)
 	}
 
 	return &pb.EmptyResponse{}, nil
 }
 
+// CreateImportJob stores new pending import job on behalf of the calling actor
+func (s Service) CreateImportJob(ctx context.Context, req *pb.CreateImportJobRequest) (*pb.ImportJobResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	conflicts, err := conflictsFromPB(req.Conflicts)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	job := ImportJob{
+		Mode:         importModeFromPB(req.Mode),
+		DryRun:       req.DryRun,
+		FileName:     req.FileName,
+		Format:       importFormats[req.Format],
+		SlugProperty: req.SlugProperty,
+		Loader:       importLoaderFromPB(req.Loader),
+		Conflicts:    conflicts,
+		Actor:        actor.FromContext(ctx),
+	}
+	if err := s.store.CreateImportJob(ctx, &job); err != nil {
+		return nil, statusError(err)
+	}
+
+	return importJobResponse(job)
+}
+
+// UpdateImportJob records the progress of the import job
+func (s Service) UpdateImportJob(ctx context.Context, req *pb.UpdateImportJobRequest) (*pb.ImportJobResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	update := ImportJobUpdate{
+		ID:        req.Id,
+		Status:    jobStatuses[req.Status],
+		ImportID:  req.ImportId,
+		Processed: req.Processed,
+		Failed:    req.Failed,
+		Errors:    req.Errors,
+		Warned:    req.Warned,
+	}
+	for _, e := range req.RecordErrors {
+		update.RecordErrors = append(update.RecordErrors, recordErrorFromPB(e))
+	}
+	for _, e := range req.RecordWarnings {
+		update.RecordWarnings = append(update.RecordWarnings, recordErrorFromPB(e))
+	}
+	if req.Summary != nil {
+		update.Summary = &ImportSummary{
+			Created:   req.Summary.Created,
+			Updated:   req.Summary.Updated,
+			Unchanged: req.Summary.Unchanged,
+			Removed:   req.Summary.Removed,
+		}
+	}
+
+	job, err := s.store.UpdateImportJob(ctx, update)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return importJobResponse(job)
+}
+
+// GetImportJob returns the import job by id
+func (s Service) GetImportJob(ctx context.Context, req *pb.ImportJobRequest) (*pb.ImportJobResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	job, err := s.store.FetchImportJob(ctx, req.Id)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return importJobResponse(job)
+}
+
+// ListImportJobs returns import jobs page by page, the newest jobs come first
+func (s Service) ListImportJobs(ctx context.Context, req *pb.ListImportJobsRequest) (*pb.ListImportJobsResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, statusError(err)
+	}
+
+	query := ImportJobsQuery{Limit: int(req.PageSize)}
+	if query.Limit == 0 {
+		query.Limit = defaultPageSize
+	}
+
+	for _, st := range req.Status {
+		query.Statuses = append(query.Statuses, jobStatuses[st])
+	}
+
+	if req.PageToken != "" {
+		before, err := decodeJobsPageToken(req.PageToken)
+		if err != nil {
+			return nil, statusError(err)
+		}
+		query.Before = before
+	}
+
+	// fetch one extra job to find out whether there is a next page
+	pageSize := query.Limit
+	query.Limit++
+	jobs, err := s.store.ListImportJobs(ctx, query)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	res := &pb.ListImportJobsResponse{}
+	if len(jobs) > pageSize {
+		jobs = jobs[:pageSize]
+		res.NextPageToken = encodeJobsPageToken(jobs[len(jobs)-1])
+	}
+
+	for _, job := range jobs {
+		proto, err := importJobToPB(job)
+		if err != nil {
+			return nil, statusError(err)
+		}
+		res.Data = append(res.Data, proto)
+	}
+
+	return res, nil
+}
+
+// statusError turns the errors of the validation and the datastore into grpc status errors
+// so the clients can tell a missing port from an invalid request, the rest is returned as it is
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var (
+		conflict *ConflictError
+		verr     validationError
+		multi    multiError
+	)
+	switch {
+	case errors.As(err, &multi):
+		return badRequestError(multi.Error(), fieldErrors(multi))
+	case errors.As(err, &verr):
+		return badRequestError(verr.Error(), fieldErrors(verr))
+	case errors.Is(err, errInvalidConflictsField), errors.Is(err, errInvalidUpdateMask), errors.Is(err, errInvalidPageToken), errors.Is(err, errPageTokenMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrMissingPortKey):
+		return badRequestError(err.Error(), []*pb.FieldError{{Field: "id", Reason: "value or slug is required", Rule: ruleRequired}})
+	case errors.Is(err, ErrPortNotFound), errors.Is(err, ErrImportNotFound), errors.Is(err, ErrImportJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrPortExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrVersionConflict), errors.Is(err, ErrImportJobFinished), errors.As(err, &conflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return err
+}
+
+// failConflicts reports the ports failing on conflict in the response and returns the rest of the ports
+func failConflicts(res *pb.UpsertPortBulkResponse, conflict *ConflictError, valid []PortEntry, indexes []int) ([]PortEntry, []int) {
+	fields := make(map[string][]string, len(conflict.Conflicts))
+	for _, c := range conflict.Conflicts {
+		fields[c.Slug] = c.Fields
+	}
+
+	var (
+		rest        = make([]PortEntry, 0, len(valid))
+		restIndexes = make([]int, 0, len(indexes))
+	)
+	for j, port := range valid {
+		conflicting, ok := fields[port.Slug]
+		if !ok {
+			rest = append(rest, port)
+			restIndexes = append(restIndexes, indexes[j])
+			continue
+		}
+
+		result := &pb.PortResult{Index: int32(indexes[j]), Slug: port.Slug, Status: pb.PortResult_FAILED}
+		for _, field := range conflicting {
+			result.Errors = append(result.Errors, &pb.FieldError{Field: field, Reason: "value conflicts with the stored one", Rule: ruleConflict})
+		}
+		res.Results[indexes[j]] = result
+		res.Failed++
+	}
+
+	return rest, restIndexes
+}
+
+// addWarnings adds the warnings of the warned ports to their results, the failed ports get none
+func addWarnings(results []*pb.PortResult, warned []*pb.PortResult) {
+	for _, w := range warned {
+		if result := results[w.Index]; result != nil && result.Status != pb.PortResult_FAILED {
+			result.Warnings = w.Warnings
+		}
+	}
+}
+
+// versionConflictError tells the client the port was changed since it has been read
+func versionConflictError(current int64) error {
+	return status.Errorf(codes.FailedPrecondition, "%s: current version is %d", ErrVersionConflict, current)
+}
+
 type IDSlugger interface {
 	GetId() *wrappers.Int64Value
 	GetSlug() *wrappers.StringValue
 }
 
-func extractIdOrSlug(req IDSlugger) (*int64, *string) {
+// extractIdOrSlug returns the id and the slug of the port the request is about, ErrMissingPortKey when it has neither
+func extractIdOrSlug(req IDSlugger) (*int64, *string, error) {
 	var (
 		id   *int64
 		slug *string
@@ -194,7 +940,11 @@ func extractIdOrSlug(req IDSlugger) (*int64, *string) {
 		slug = &req.GetSlug().Value
 	}
 
-	return id, slug
+	if id == nil && slug == nil {
+		return nil, nil, ErrMissingPortKey
+	}
+
+	return id, slug, nil
 }
 
 func pbToPorts(protos []*pb.Port) []PortEntry {
@@ -241,6 +991,209 @@ func pbPortToModel(proto *pb.Port) PortEntry {
 	return pe
 }
 
+func portChangeToPB(change PortChange) (*pb.PortChange, error) {
+	changedAt, err := ptypes.TimestampProto(change.ChangedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	proto := &pb.PortChange{
+		Version:   change.Version,
+		Operation: change.Operation,
+		Actor:     change.Actor,
+		ChangedAt: changedAt,
+	}
+
+	if change.OldValue != nil {
+		proto.OldValue = portToPB(change.OldValue.PortEntry)
+	}
+
+	if change.NewValue != nil {
+		proto.NewValue = portToPB(change.NewValue.PortEntry)
+	}
+
+	return proto, nil
+}
+
+// jobStatuses maps import job statuses of the api to the stored ones
+var jobStatuses = map[pb.ImportJobStatus]string{
+	pb.ImportJobStatus_IMPORT_JOB_STATUS_PENDING:   ImportJobPending,
+	pb.ImportJobStatus_IMPORT_JOB_STATUS_RUNNING:   ImportJobRunning,
+	pb.ImportJobStatus_IMPORT_JOB_STATUS_SUCCEEDED: ImportJobSucceeded,
+	pb.ImportJobStatus_IMPORT_JOB_STATUS_FAILED:    ImportJobFailed,
+}
+
+// importFormats maps import formats of the api to the stored ones, the auto detected format is stored empty
+var importFormats = map[pb.ImportFormat]string{
+	pb.ImportFormat_IMPORT_FORMAT_AUTO:     "",
+	pb.ImportFormat_IMPORT_FORMAT_OBJECT:   "object",
+	pb.ImportFormat_IMPORT_FORMAT_ARRAY:    "array",
+	pb.ImportFormat_IMPORT_FORMAT_NDJSON:   "ndjson",
+	pb.ImportFormat_IMPORT_FORMAT_GEOJSON:  "geojson",
+	pb.ImportFormat_IMPORT_FORMAT_CSV:      "csv",
+	pb.ImportFormat_IMPORT_FORMAT_UNLOCODE: "unlocode",
+}
+
+// conflictPolicies maps conflict policies of the api to the stored ones
+var conflictPolicies = map[pb.ConflictPolicy]ConflictPolicy{
+	pb.ConflictPolicy_CONFLICT_POLICY_OVERWRITE:        ConflictOverwrite,
+	pb.ConflictPolicy_CONFLICT_POLICY_SKIP_EXISTING:    ConflictSkipExisting,
+	pb.ConflictPolicy_CONFLICT_POLICY_FILL_EMPTY_ONLY:  ConflictFillEmptyOnly,
+	pb.ConflictPolicy_CONFLICT_POLICY_FAIL_ON_CONFLICT: ConflictFail,
+}
+
+// conflictsFromPB reads the conflict policies, it fails for the policies of unknown fields
+func conflictsFromPB(proto *pb.Conflicts) (ConflictOptions, error) {
+	conflicts := ConflictOptions{Policy: conflictPolicies[proto.GetPolicy()]}
+	if len(proto.GetFieldPolicies()) > 0 {
+		conflicts.Fields = make(FieldPolicies, len(proto.FieldPolicies))
+		for field, policy := range proto.FieldPolicies {
+			conflicts.Fields[field] = conflictPolicies[policy]
+		}
+	}
+
+	return conflicts, conflicts.validate()
+}
+
+func conflictsToPB(conflicts ConflictOptions) *pb.Conflicts {
+	proto := &pb.Conflicts{}
+	for policy, p := range conflictPolicies {
+		if p == conflicts.Policy {
+			proto.Policy = policy
+		}
+	}
+
+	if len(conflicts.Fields) > 0 {
+		proto.FieldPolicies = make(map[string]pb.ConflictPolicy, len(conflicts.Fields))
+		for field, p := range conflicts.Fields {
+			for policy, name := range conflictPolicies {
+				if name == p {
+					proto.FieldPolicies[field] = policy
+				}
+			}
+		}
+	}
+
+	return proto
+}
+
+func importLoaderFromPB(loader pb.ImportLoader) ImportLoader {
+	if loader == pb.ImportLoader_IMPORT_LOADER_COPY {
+		return LoaderCopy
+	}
+
+	return LoaderInsert
+}
+
+func importModeFromPB(mode pb.ImportMode) ImportMode {
+	if mode == pb.ImportMode_IMPORT_MODE_SYNC {
+		return ImportSync
+	}
+
+	return ImportUpsert
+}
+
+func importJobResponse(job ImportJob) (*pb.ImportJobResponse, error) {
+	proto, err := importJobToPB(job)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ImportJobResponse{Data: proto}, nil
+}
+
+func importJobToPB(job ImportJob) (*pb.ImportJob, error) {
+	proto := &pb.ImportJob{
+		Id:           job.ID,
+		Mode:         pb.ImportMode_IMPORT_MODE_UPSERT,
+		DryRun:       job.DryRun,
+		FileName:     job.FileName,
+		SlugProperty: job.SlugProperty,
+		Actor:        job.Actor,
+		ImportId:     job.ImportID,
+		Processed:    job.Processed,
+		Failed:       job.Failed,
+		Errors:       job.Errors,
+		Warned:       job.Warned,
+	}
+
+	if job.Mode == ImportSync {
+		proto.Mode = pb.ImportMode_IMPORT_MODE_SYNC
+	}
+
+	if job.Loader == LoaderCopy {
+		proto.Loader = pb.ImportLoader_IMPORT_LOADER_COPY
+	}
+	proto.Conflicts = conflictsToPB(job.Conflicts)
+
+	for _, e := range job.RecordErrors {
+		proto.RecordErrors = append(proto.RecordErrors, recordErrorToPB(e))
+	}
+
+	for _, e := range job.RecordWarnings {
+		proto.RecordWarnings = append(proto.RecordWarnings, recordErrorToPB(e))
+	}
+
+	for st, name := range jobStatuses {
+		if name == job.Status {
+			proto.Status = st
+		}
+	}
+
+	for format, name := range importFormats {
+		if name == job.Format {
+			proto.Format = format
+		}
+	}
+
+	if job.Status == ImportJobSucceeded {
+		proto.Summary = &pb.PortsImportSummary{
+			Created:   job.Summary.Created,
+			Updated:   job.Summary.Updated,
+			Unchanged: job.Summary.Unchanged,
+			Removed:   job.Summary.Removed,
+			DryRun:    job.DryRun,
+		}
+	}
+
+	var err error
+	if proto.CreatedAt, err = ptypes.TimestampProto(job.CreatedAt); err != nil {
+		return nil, err
+	}
+	if proto.UpdatedAt, err = ptypes.TimestampProto(job.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if job.FinishedAt != nil {
+		if proto.FinishedAt, err = ptypes.TimestampProto(*job.FinishedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return proto, nil
+}
+
+func recordErrorFromPB(proto *pb.RecordError) RecordError {
+	e := RecordError{Key: proto.Key, Offset: proto.Offset, File: proto.File}
+	for _, fe := range proto.Errors {
+		e.Errors = append(e.Errors, FieldError{Field: fe.Field, Reason: fe.Reason, Rule: fe.Rule})
+	}
+
+	return e
+}
+
+func recordErrorToPB(e RecordError) *pb.RecordError {
+	return &pb.RecordError{Key: e.Key, Offset: e.Offset, File: e.File, Errors: fieldErrorsToPB(e.Errors)}
+}
+
+func fieldErrorsToPB(errs []FieldError) []*pb.FieldError {
+	var protos []*pb.FieldError
+	for _, fe := range errs {
+		protos = append(protos, &pb.FieldError{Field: fe.Field, Reason: fe.Reason, Rule: fe.Rule})
+	}
+
+	return protos
+}
+
 func portToPB(port PortEntry) *pb.Port {
 	return &pb.Port{
 		Slug:     port.Slug,
@@ -258,5 +1211,6 @@ func portToPB(port PortEntry) *pb.Port {
 		Timezone: port.Timezone,
 		Unlocks:  port.Unlocks,
 		Code:     port.Code,
+		Version:  port.Version,
 	}
 }
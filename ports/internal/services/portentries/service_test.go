@@ -3,139 +3,1112 @@ package portentries
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/kreyyser/transshipment/common/locode"
 	pb "github.com/kreyyser/transshipment/pb/portentries"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"strings"
 	"testing"
+	"time"
 )
 
-type DBMock struct {
-	err  error
-	resp interface{}
+// failingStore is the memory store failing the port writes and reads with err
+type failingStore struct {
+	*MemStore
+	err error
 }
 
-func (m *DBMock) Reset() {
-	m.err = nil
-	m.resp = nil
+func (s *failingStore) Upsert(_ context.Context, _ PortEntry, _ ConflictOptions) (PortEntry, error) {
+	return PortEntry{}, s.err
 }
 
-func (m *DBMock) SetErr(err error) {
-	m.err = err
+func (s *failingStore) Fetch(_ context.Context, _ *int64, _ *string) (PortEntry, error) {
+	return PortEntry{}, s.err
 }
 
-func (m *DBMock) SetResp(resp interface{}) {
-	m.resp = resp
+// seededStore is the memory store holding the given ports, ids are assigned in the given order
+func seededStore(t *testing.T, ports ...PortEntry) *MemStore {
+	store := NewMemStore()
+	for _, port := range ports {
+		port := port
+		require.NoError(t, store.Store(context.Background(), &port))
+	}
+
+	return store
 }
 
-func (m *DBMock) List(_ context.Context) ([]PortEntry, error) {
-	if m.err != nil {
-		return nil, m.err
+func TestService_CreateOrUpdatePort(t *testing.T) {
+	cases := []struct {
+		name  string
+		req   *pb.CreatePortRequest
+		res   *pb.EmptyResponse
+		err   error
+		dbErr error
+	}{
+		{
+			name: "created",
+			req:  &pb.CreatePortRequest{Data: &pb.Port{Slug: "NLRTM", Name: "Rotterdam"}},
+			res:  &pb.EmptyResponse{},
+		},
+		{
+			name:  "db err",
+			req:   &pb.CreatePortRequest{Data: &pb.Port{}},
+			err:   errors.New("some db error occured"),
+			dbErr: errors.New("some db error occured"),
+		},
 	}
 
-	if res, ok := m.resp.([]PortEntry); ok {
-		return res, nil
-	}
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			var store PortsDB = NewMemStore()
+			if test.dbErr != nil {
+				store = &failingStore{MemStore: NewMemStore(), err: test.dbErr}
+			}
+			service := Service{
+				store: store,
+			}
 
-	return []PortEntry{}, nil
+			resp, err := service.CreateOrUpdatePort(context.Background(), test.req)
+			if test.err != nil {
+				r.Error(err)
+				return
+			}
+			r.NoError(err)
+			r.Equal(test.res, resp)
+
+			port, err := service.store.Fetch(context.Background(), nil, &test.req.Data.Slug)
+			r.NoError(err)
+			r.Equal(test.req.Data.Name, port.Name)
+		})
+	}
 }
 
-func (m *DBMock) Upsert(_ context.Context, port PortEntry) (PortEntry, error) {
-	if m.err != nil {
-		return port, m.err
+func TestService_ListPorts(t *testing.T) {
+	cases := []struct {
+		name     string
+		req      *pb.ListPortsRequest
+		stored   []PortEntry
+		err      bool
+		size     int
+		hasToken bool
+	}{
+		{
+			name:   "last page",
+			req:    &pb.ListPortsRequest{PageSize: 2},
+			stored: []PortEntry{{Slug: "NLRTM"}, {Slug: "BEANR"}},
+			size:   2,
+		},
+		{
+			name:     "has next page",
+			req:      &pb.ListPortsRequest{PageSize: 2},
+			stored:   []PortEntry{{Slug: "NLRTM"}, {Slug: "BEANR"}, {Slug: "DEHAM"}},
+			size:     2,
+			hasToken: true,
+		},
+		{
+			name: "malformed page token",
+			req:  &pb.ListPortsRequest{PageToken: "not a token"},
+			err:  true,
+		},
+		{
+			name: "page size too big",
+			req:  &pb.ListPortsRequest{PageSize: 5000},
+			err:  true,
+		},
 	}
 
-	if res, ok := m.resp.(PortEntry); ok {
-		return res, nil
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			service := Service{
+				store: seededStore(t, test.stored...),
+			}
+
+			resp, err := service.ListPorts(context.Background(), test.req)
+			if test.err {
+				r.Error(err)
+				return
+			}
+			r.NoError(err)
+			r.Len(resp.Data, test.size)
+			r.Equal(test.hasToken, resp.NextPageToken != "")
+		})
 	}
+}
+
+func TestPageToken(t *testing.T) {
+	r := require.New(t)
+	query := ListQuery{OrderBy: "name", Descending: true}
+
+	token := encodePageToken(query, PortEntry{ID: 42, Name: "Rotterdam"})
+	cursor, err := decodePageToken(token, query)
+	r.NoError(err)
+	r.Equal(&Cursor{Value: "Rotterdam", ID: 42}, cursor)
 
-	return port, nil
+	_, err = decodePageToken(token, ListQuery{OrderBy: "name"})
+	r.Error(err)
+
+	asOf := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	filtered := ListQuery{OrderBy: "name", Country: "Netherlands", AsOf: &asOf}
+	token = encodePageToken(filtered, PortEntry{ID: 42, Name: "Rotterdam"})
+	_, err = decodePageToken(token, filtered)
+	r.NoError(err)
+
+	for _, other := range []ListQuery{
+		{OrderBy: "name"},
+		{OrderBy: "name", Country: "Belgium", AsOf: &asOf},
+		{OrderBy: "name", Country: "Netherlands"},
+		{OrderBy: "name", Country: "Netherlands", AsOf: &asOf, Region: "Europe"},
+	} {
+		_, err = decodePageToken(token, other)
+		r.True(errors.Is(err, errPageTokenMismatch))
+	}
 }
 
-func (m *DBMock) BulkUpsert(_ context.Context, ports []PortEntry) ([]PortEntry, error) {
-	if m.err != nil {
-		return ports, m.err
+func TestService_UpdatePort(t *testing.T) {
+	cases := []struct {
+		name string
+		req  *pb.UpdatePortRequest
+		code codes.Code
+	}{
+		{
+			name: "matching version",
+			req: &pb.UpdatePortRequest{
+				Id:              &wrappers.Int64Value{Value: 1},
+				Data:            &pb.PortUpdatable{Name: &wrappers.StringValue{Value: "Rotterdam"}},
+				ExpectedVersion: &wrappers.Int64Value{Value: 2},
+			},
+			code: codes.OK,
+		},
+		{
+			name: "stale version",
+			req: &pb.UpdatePortRequest{
+				Id:              &wrappers.Int64Value{Value: 1},
+				Data:            &pb.PortUpdatable{Name: &wrappers.StringValue{Value: "Rotterdam"}},
+				ExpectedVersion: &wrappers.Int64Value{Value: 1},
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "missing port",
+			req: &pb.UpdatePortRequest{
+				Id:   &wrappers.Int64Value{Value: 42},
+				Data: &pb.PortUpdatable{Name: &wrappers.StringValue{Value: "Rotterdam"}},
+			},
+			code: codes.NotFound,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			store := NewMemStore()
+			port := PortEntry{Slug: "NLRTM", Name: "Europoort"}
+			r.NoError(store.Store(context.Background(), &port))
+			port.Name = "Rotterdam Europoort"
+			r.NoError(store.Update(context.Background(), &port))
+			service := Service{
+				store: store,
+			}
+
+			resp, err := service.UpdatePort(context.Background(), test.req)
+			r.Equal(test.code, status.Code(err))
+			if test.code == codes.OK {
+				r.Equal("Rotterdam", resp.Data.Name)
+				r.Equal(int64(3), resp.Data.Version)
+			}
+		})
 	}
+}
 
-	if res, ok := m.resp.([]PortEntry); ok {
-		return res, nil
+func TestService_UpdatePortMask(t *testing.T) {
+	stored := PortEntry{
+		ID:        1,
+		Slug:      "NLRTM",
+		Name:      "Rotterdam",
+		Province:  "Zuid-Holland",
+		Alias:     []string{"Rotterdam Europoort"},
+		Latitude:  51.92,
+		Longitude: 4.48,
+		Timezone:  "Europe/Amsterdam",
+		Version:   1,
 	}
+	cases := []struct {
+		name     string
+		data     *pb.PortUpdatable
+		paths    []string
+		expected func(p *PortEntry)
+		code     codes.Code
+	}{
+		{
+			name:     "clears listed fields missing from data",
+			data:     &pb.PortUpdatable{},
+			paths:    []string{"province", "alias"},
+			expected: func(p *PortEntry) { p.Province, p.Alias = "", nil },
+		},
+		{
+			name:     "ignores fields not listed",
+			data:     &pb.PortUpdatable{Name: &wrappers.StringValue{Value: "Port of Rotterdam"}, Timezone: &wrappers.StringValue{Value: "UTC"}},
+			paths:    []string{"name"},
+			expected: func(p *PortEntry) { p.Name = "Port of Rotterdam" },
+		},
+		{
+			name:     "single coordinate",
+			data:     &pb.PortUpdatable{Coordinates: &pb.Coordinates{Lat: 52, Lng: 5}},
+			paths:    []string{"coordinates.lat"},
+			expected: func(p *PortEntry) { p.Latitude = 52 },
+		},
+		{
+			name:     "empty mask",
+			data:     &pb.PortUpdatable{Name: &wrappers.StringValue{Value: "Port of Rotterdam"}},
+			expected: func(p *PortEntry) {},
+		},
+		{
+			name:  "unknown field",
+			data:  &pb.PortUpdatable{},
+			paths: []string{"slug"},
+			code:  codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			service := Service{store: seededStore(t, stored)}
 
-	return ports, nil
+			resp, err := service.UpdatePort(context.Background(), &pb.UpdatePortRequest{
+				Id:         &wrappers.Int64Value{Value: 1},
+				Data:       test.data,
+				UpdateMask: &field_mask.FieldMask{Paths: test.paths},
+			})
+			r.Equal(test.code, status.Code(err))
+			if test.code != codes.OK {
+				return
+			}
+
+			expected := stored
+			test.expected(&expected)
+			if !samePort(stored, expected) {
+				expected.Version++
+			}
+			r.Equal(portToPB(expected), resp.Data)
+		})
+	}
 }
 
-func (m *DBMock) Fetch(_ context.Context, id *int64, slug *string) (PortEntry, error) {
-	if m.err != nil {
-		return PortEntry{}, m.err
+func TestService_BatchGetPorts(t *testing.T) {
+	store := NewMemStore()
+	for _, port := range []PortEntry{
+		{Slug: "NLRTM", Name: "Rotterdam", Unlocks: []string{"NLRTM", "NLEUR"}},
+		{Slug: "BEANR", Name: "Antwerp", Unlocks: []string{"BEANR"}},
+		{Slug: "DEHAM", Name: "Hamburg", Unlocks: []string{"DEHAM", "NLEUR"}},
+		{Slug: "NLRT2", Name: "Rotterdam Botlek"},
+	} {
+		port := port
+		require.NoError(t, store.Store(context.Background(), &port))
 	}
+	service := Service{store: store}
 
-	if res, ok := m.resp.(PortEntry); ok {
-		return res, nil
+	slug := func(s string) *pb.PortKey { return &pb.PortKey{Key: &pb.PortKey_Slug{Slug: s}} }
+	unlock := func(s string) *pb.PortKey { return &pb.PortKey{Key: &pb.PortKey_Unlock{Unlock: s}} }
+	id := func(n int64) *pb.PortKey { return &pb.PortKey{Key: &pb.PortKey_Id{Id: n}} }
+
+	cases := []struct {
+		name     string
+		keys     []*pb.PortKey
+		slugs    []string
+		notFound []*pb.PortKey
+		code     codes.Code
+	}{
+		{
+			name:  "request order",
+			keys:  []*pb.PortKey{slug("DEHAM"), id(1), slug("BEANR")},
+			slugs: []string{"DEHAM", "NLRTM", "BEANR"},
+		},
+		{
+			name:     "not found",
+			keys:     []*pb.PortKey{slug("NLAMS"), slug("BEANR"), id(42), unlock("USNYC")},
+			slugs:    []string{"BEANR"},
+			notFound: []*pb.PortKey{slug("NLAMS"), id(42), unlock("USNYC")},
+		},
+		{
+			name:     "mixed keys with missing ones",
+			keys:     []*pb.PortKey{id(3), slug("NLAMS"), unlock("NLEUR"), id(9), unlock("USNYC"), slug("BEANR")},
+			slugs:    []string{"DEHAM", "NLRTM", "BEANR"},
+			notFound: []*pb.PortKey{slug("NLAMS"), id(9), unlock("USNYC")},
+		},
+		{
+			name:  "port found by several keys is listed once",
+			keys:  []*pb.PortKey{unlock("BEANR"), slug("BEANR"), id(2)},
+			slugs: []string{"BEANR"},
+		},
+		{
+			name:  "unlock of several ports",
+			keys:  []*pb.PortKey{unlock("NLEUR")},
+			slugs: []string{"NLRTM", "DEHAM"},
+		},
+		{
+			name: "no keys",
+			code: codes.InvalidArgument,
+		},
+		{
+			name:     "blank and malformed keys",
+			keys:     []*pb.PortKey{slug("nlrtm"), {}, unlock(""), slug("NLRTM"), nil, slug("NLRTM12")},
+			slugs:    []string{"NLRTM"},
+			notFound: []*pb.PortKey{slug("nlrtm"), {}, unlock(""), {}, slug("NLRTM12")},
+		},
+		{
+			name:  "slug with digits",
+			keys:  []*pb.PortKey{slug("NLRT2")},
+			slugs: []string{"NLRT2"},
+		},
 	}
 
-	return PortEntry{}, nil
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			res, err := service.BatchGetPorts(context.Background(), &pb.BatchGetPortsRequest{Keys: test.keys})
+			r.Equal(test.code, status.Code(err))
+			if test.code != codes.OK {
+				return
+			}
+
+			var slugs []string
+			for _, port := range res.Data {
+				slugs = append(slugs, port.Slug)
+			}
+			r.Equal(test.slugs, slugs)
+			r.Len(res.NotFound, len(test.notFound))
+			for i, key := range test.notFound {
+				r.True(proto.Equal(key, res.NotFound[i]))
+			}
+		})
+	}
 }
 
-func (m *DBMock) Store(_ context.Context, port *PortEntry) error {
-	if m.err != nil {
-		return m.err
+func TestService_FetchPortErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		req   *pb.PortRequest
+		dbErr error
+		code  codes.Code
+	}{
+		{
+			name: "missing port",
+			req:  &pb.PortRequest{Slug: &wrappers.StringValue{Value: "NLRTM"}},
+			code: codes.NotFound,
+		},
+		{
+			name: "invalid slug",
+			req:  &pb.PortRequest{Slug: &wrappers.StringValue{Value: "rtm"}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "no id or slug",
+			req:  &pb.PortRequest{},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "invalid as_of",
+			req:  &pb.PortRequest{Slug: &wrappers.StringValue{Value: "BEANR"}, AsOf: &timestamp.Timestamp{Seconds: -62135596801}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "db failure",
+			req:   &pb.PortRequest{Id: &wrappers.Int64Value{Value: 1}},
+			dbErr: errors.New("connection refused"),
+			code:  codes.Unknown,
+		},
 	}
 
-	return nil
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			var store PortsDB = seededStore(t, PortEntry{Slug: "BEANR"})
+			if test.dbErr != nil {
+				store = &failingStore{MemStore: NewMemStore(), err: test.dbErr}
+			}
+			service := Service{
+				store: store,
+			}
+
+			_, err := service.FetchPort(context.Background(), test.req)
+			require.Equal(t, test.code, status.Code(err))
+		})
+	}
 }
 
-func (m *DBMock) Update(_ context.Context, port *PortEntry) error {
-	if m.err != nil {
-		return m.err
+func TestService_DeletePort(t *testing.T) {
+	cases := []struct {
+		name  string
+		req   *pb.PortRequest
+		code  codes.Code
+		ports int
+	}{
+		{
+			name:  "by slug",
+			req:   &pb.PortRequest{Slug: &wrappers.StringValue{Value: "NLRTM"}},
+			ports: 1,
+		},
+		{
+			name:  "by id and expected version",
+			req:   &pb.PortRequest{Id: &wrappers.Int64Value{Value: 2}, ExpectedVersion: &wrappers.Int64Value{Value: 1}},
+			ports: 1,
+		},
+		{
+			name:  "stale version",
+			req:   &pb.PortRequest{Slug: &wrappers.StringValue{Value: "NLRTM"}, ExpectedVersion: &wrappers.Int64Value{Value: 5}},
+			code:  codes.FailedPrecondition,
+			ports: 2,
+		},
+		{
+			name:  "missing port",
+			req:   &pb.PortRequest{Slug: &wrappers.StringValue{Value: "DEHAM"}},
+			code:  codes.NotFound,
+			ports: 2,
+		},
+		{
+			name:  "id of other port",
+			req:   &pb.PortRequest{Id: &wrappers.Int64Value{Value: 2}, Slug: &wrappers.StringValue{Value: "NLRTM"}},
+			code:  codes.NotFound,
+			ports: 2,
+		},
+		{
+			name:  "no id or slug",
+			req:   &pb.PortRequest{},
+			code:  codes.InvalidArgument,
+			ports: 2,
+		},
+		{
+			name:  "expected version only",
+			req:   &pb.PortRequest{ExpectedVersion: &wrappers.Int64Value{Value: 1}},
+			code:  codes.InvalidArgument,
+			ports: 2,
+		},
 	}
 
-	return nil
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			store := seededStore(t, PortEntry{Slug: "NLRTM"}, PortEntry{Slug: "BEANR"})
+			service := Service{store: store}
+
+			_, err := service.DeletePort(context.Background(), test.req)
+			r.Equal(test.code, status.Code(err))
+			r.Len(store.ports, test.ports)
+		})
+	}
 }
 
-func (m *DBMock) Delete(_ context.Context, id *int64, slug *string) error {
-	if m.err != nil {
-		return m.err
+// requireViolations checks the error is InvalidArgument status with the violations in both BadRequest and FieldErrors details
+func requireViolations(t *testing.T, err error, violations []*pb.FieldError) {
+	r := require.New(t)
+	st := status.Convert(err)
+	r.Equal(codes.InvalidArgument, st.Code())
+	r.Len(st.Details(), 2)
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	r.True(ok)
+	r.Len(badRequest.FieldViolations, len(violations))
+	for i, v := range violations {
+		r.Equal(v.Field, badRequest.FieldViolations[i].Field)
+		r.Equal(v.Reason, badRequest.FieldViolations[i].Description)
 	}
 
-	return nil
+	fieldErrors, ok := st.Details()[1].(*pb.FieldErrors)
+	r.True(ok)
+	r.Len(fieldErrors.Errors, len(violations))
+	for i, v := range violations {
+		r.True(proto.Equal(v, fieldErrors.Errors[i]), fieldErrors.Errors[i].String())
+	}
 }
 
-func TestService_CreateOrUpdatePort(t *testing.T) {
-	r := require.New(t)
-	db := &DBMock{}
+func TestService_CreatePortViolations(t *testing.T) {
 	service := Service{
-		store: db,
+		store: NewMemStore(),
 	}
-	cases := []struct{
-		name  string
-		req   *pb.CreatePortRequest
-		res   *pb.EmptyResponse
-		err   error
-		dbErr error
-		dbRes *PortEntry
+	cases := []struct {
+		name       string
+		req        *pb.CreatePortRequest
+		violations []*pb.FieldError
 	}{
 		{
-			name:  "db err",
-			req:   &pb.CreatePortRequest{Data: &pb.Port{}},
-			err:   errors.New("some db error occured"),
-			dbErr: errors.New("some db error occured"),
+			name: "missing port",
+			req:  &pb.CreatePortRequest{},
+			violations: []*pb.FieldError{
+				{Field: "data", Reason: "value is required", Rule: "required"},
+			},
+		},
+		{
+			name: "short slug",
+			req:  &pb.CreatePortRequest{Data: &pb.Port{Slug: "RTM"}},
+			violations: []*pb.FieldError{
+				{Field: "data.slug", Reason: "value length must be 5 runes", Rule: "len"},
+			},
+		},
+		{
+			name: "latitude out of range",
+			req:  &pb.CreatePortRequest{Data: &pb.Port{Slug: "NLRTM", Coordinates: &pb.Coordinates{Lat: 91}}},
+			violations: []*pb.FieldError{
+				{Field: "data.coordinates.lat", Reason: "value must be inside range [-90, 90]", Rule: "range"},
+			},
+		},
+		{
+			name: "several invalid fields",
+			req: &pb.CreatePortRequest{Data: &pb.Port{
+				Slug:        "rtm",
+				Coordinates: &pb.Coordinates{Lat: 91, Lng: -181},
+			}},
+			violations: []*pb.FieldError{
+				{Field: "data.slug", Reason: "value length must be 5 runes", Rule: "len"},
+				{Field: "data.slug", Reason: `value does not match regex pattern "^[A-Z0-9]+$"`, Rule: "pattern"},
+				{Field: "data.coordinates.lng", Reason: "value must be inside range [-180, 180]", Rule: "range"},
+				{Field: "data.coordinates.lat", Reason: "value must be inside range [-90, 90]", Rule: "range"},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := service.CreatePort(context.Background(), test.req)
+			requireViolations(t, err, test.violations)
+		})
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: ErrPortNotFound, code: codes.NotFound},
+		{err: ErrImportJobNotFound, code: codes.NotFound},
+		{err: ErrPortExists, code: codes.AlreadyExists},
+		{err: ErrVersionConflict, code: codes.FailedPrecondition},
+		{err: &ConflictError{Conflicts: []PortConflict{{Slug: "NLRTM", Fields: []string{"name"}}}, Total: 1}, code: codes.FailedPrecondition},
+		{err: errInvalidPageToken, code: codes.InvalidArgument},
+		{err: fmt.Errorf("%w %q", errInvalidConflictsField, "depth"), code: codes.InvalidArgument},
+		{err: status.Error(codes.Unavailable, "try later"), code: codes.Unavailable},
+	}
+
+	for _, test := range cases {
+		t.Run(test.err.Error(), func(t *testing.T) {
+			require.Equal(t, test.code, status.Code(statusError(test.err)))
+		})
+	}
+}
+
+func TestService_CreateOrUpdatePortBulk(t *testing.T) {
+	valid := &pb.Port{Slug: "NLRTM", Name: "Rotterdam"}
+	badLat := &pb.Port{Slug: "DEHAM", Coordinates: &pb.Coordinates{Lat: 91}}
+	badSlug := &pb.Port{Slug: "nl"}
+
+	cases := []struct {
+		name     string
+		req      *pb.UpsertPortBulkRequest
+		statuses []pb.PortResult_Status
+		errors   map[int][]*pb.FieldError
+	}{
+		{
+			name:     "all valid",
+			req:      &pb.UpsertPortBulkRequest{Data: []*pb.Port{valid}},
+			statuses: []pb.PortResult_Status{pb.PortResult_CREATED},
+		},
+		{
+			name:     "failed skip the rest",
+			req:      &pb.UpsertPortBulkRequest{Data: []*pb.Port{valid, badLat, badSlug}},
+			statuses: []pb.PortResult_Status{pb.PortResult_SKIPPED, pb.PortResult_FAILED, pb.PortResult_FAILED},
+			errors: map[int][]*pb.FieldError{
+				1: {{Field: "coordinates.lat", Reason: "value must be inside range [-90, 90]", Rule: "range"}},
+				2: {
+					{Field: "slug", Reason: "value length must be 5 runes", Rule: "len"},
+					{Field: "slug", Reason: `value does not match regex pattern "^[A-Z0-9]+$"`, Rule: "pattern"},
+				},
+			},
+		},
+		{
+			name:     "continue on error",
+			req:      &pb.UpsertPortBulkRequest{Data: []*pb.Port{badLat, valid}, ContinueOnError: true},
+			statuses: []pb.PortResult_Status{pb.PortResult_FAILED, pb.PortResult_CREATED},
+		},
+		{
+			name:     "repeated slug",
+			req:      &pb.UpsertPortBulkRequest{Data: []*pb.Port{valid, valid}, ContinueOnError: true},
+			statuses: []pb.PortResult_Status{pb.PortResult_CREATED, pb.PortResult_FAILED},
+			errors: map[int][]*pb.FieldError{
+				1: {{Field: "slug", Reason: "value is repeated in the request", Rule: "unique"}},
+			},
 		},
 	}
-	
+
 	for _, test := range cases {
 		t.Run(test.name, func(t *testing.T) {
-			defer db.Reset()
-			if test.dbRes != nil {
-				db.SetResp(*test.dbRes)
+			r := require.New(t)
+			service := Service{
+				store: NewMemStore(),
 			}
-			if test.dbErr != nil {
-				db.SetErr(test.dbErr)
+
+			resp, err := service.CreateOrUpdatePortBulk(context.Background(), test.req)
+			r.NoError(err)
+			r.Len(resp.Results, len(test.statuses))
+
+			for i, result := range resp.Results {
+				r.Equal(int32(i), result.Index)
+				r.Equal(test.statuses[i], result.Status)
+				if errs, ok := test.errors[i]; ok {
+					r.Len(result.Errors, len(errs))
+					for j := range errs {
+						r.True(proto.Equal(errs[j], result.Errors[j]), result.Errors[j].String())
+					}
+				}
 			}
+		})
+	}
+}
 
-			resp, err := service.CreateOrUpdatePort(context.Background(), test.req)
-			if test.err != nil {
+func TestService_CreateOrUpdatePortBulkConflicts(t *testing.T) {
+	conflicts := &pb.Conflicts{
+		Policy:        pb.ConflictPolicy_CONFLICT_POLICY_FILL_EMPTY_ONLY,
+		FieldPolicies: map[string]pb.ConflictPolicy{"timezone": pb.ConflictPolicy_CONFLICT_POLICY_FAIL_ON_CONFLICT},
+	}
+
+	cases := []struct {
+		name     string
+		req      *pb.UpsertPortBulkRequest
+		statuses []pb.PortResult_Status
+		city     string
+		err      string
+	}{
+		{
+			name: "conflict skips the rest",
+			req: &pb.UpsertPortBulkRequest{Conflicts: conflicts, Data: []*pb.Port{
+				{Slug: "NLRTM", City: "Rotterdam"},
+				{Slug: "DEHAM", Timezone: "Europe/Berlin"},
+			}},
+			statuses: []pb.PortResult_Status{pb.PortResult_SKIPPED, pb.PortResult_FAILED},
+		},
+		{
+			name: "continue on error",
+			req: &pb.UpsertPortBulkRequest{Conflicts: conflicts, ContinueOnError: true, Data: []*pb.Port{
+				{Slug: "NLRTM", Name: "Port of Rotterdam", City: "Rotterdam"},
+				{Slug: "DEHAM", Timezone: "Europe/Berlin"},
+				{Slug: "BEANR", Name: "Antwerp"},
+			}},
+			statuses: []pb.PortResult_Status{pb.PortResult_UPDATED, pb.PortResult_FAILED, pb.PortResult_CREATED},
+			city:     "Rotterdam",
+		},
+		{
+			name: "unknown field",
+			req: &pb.UpsertPortBulkRequest{
+				Conflicts: &pb.Conflicts{FieldPolicies: map[string]pb.ConflictPolicy{"version": pb.ConflictPolicy_CONFLICT_POLICY_SKIP_EXISTING}},
+				Data:      []*pb.Port{{Slug: "NLRTM"}},
+			},
+			err: `invalid conflicts field "version"`,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			ctx := context.Background()
+			store := NewMemStore()
+			service := NewPortsService(store, nil)
+
+			_, err := store.BulkUpsert(ctx, []PortEntry{
+				{Slug: "NLRTM", Name: "Rotterdam"},
+				{Slug: "DEHAM", Name: "Hamburg", Timezone: "Europe/Paris"},
+			}, ConflictOptions{})
+			r.NoError(err)
+
+			resp, err := service.CreateOrUpdatePortBulk(ctx, test.req)
+			if test.err != "" {
 				r.Error(err)
+				r.Contains(err.Error(), test.err)
 				return
 			}
-			r.Equal(resp, test.res)
+			r.NoError(err)
+
+			r.Len(resp.Results, len(test.statuses))
+			for i, result := range resp.Results {
+				r.Equal(test.statuses[i], result.Status)
+				if result.Status == pb.PortResult_FAILED {
+					r.Equal([]*pb.FieldError{{Field: "timezone", Reason: "value conflicts with the stored one", Rule: "conflict"}}, result.Errors)
+				}
+			}
+			r.Equal(int64(1), resp.Failed)
+
+			slug := "NLRTM"
+			port, err := store.Fetch(ctx, nil, &slug)
+			r.NoError(err)
+			r.Equal("Rotterdam", port.Name)
+			r.Equal(test.city, port.City)
+		})
+	}
+}
+
+// exportStream collects the batches ExportPorts sends
+type exportStream struct {
+	grpc.ServerStream
+	batches [][]*pb.Port
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) Send(res *pb.ExportPortsResponse) error {
+	s.batches = append(s.batches, res.Data)
+	return nil
+}
+
+func TestService_ExportPorts(t *testing.T) {
+	r := require.New(t)
+	store := NewMemStore()
+
+	ports := make([]PortEntry, 0, exportBatchSize*2+1)
+	for i := 0; i < cap(ports); i++ {
+		country := "Netherlands"
+		if i%2 == 1 {
+			country = "Germany"
+		}
+		ports = append(ports, PortEntry{Slug: fmt.Sprintf("XX%03d", i), Name: fmt.Sprintf("Port %d", i), Country: country})
+	}
+	_, err := store.BulkUpsert(context.Background(), ports, ConflictOptions{})
+	r.NoError(err)
+
+	cases := []struct {
+		name    string
+		req     *pb.ExportPortsRequest
+		batches int
+		ports   int
+		first   string
+	}{
+		{
+			name:    "every port",
+			req:     &pb.ExportPortsRequest{},
+			batches: 3,
+			ports:   exportBatchSize*2 + 1,
+			first:   "XX000",
+		},
+		{
+			name:    "filtered and ordered",
+			req:     &pb.ExportPortsRequest{Country: "Germany", OrderBy: "slug", Descending: true},
+			batches: 1,
+			ports:   exportBatchSize,
+			first:   "XX999",
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			stream := &exportStream{}
+			r.NoError(NewPortsService(store, nil).ExportPorts(test.req, stream))
+			r.Len(stream.batches, test.batches)
+
+			seen := map[string]bool{}
+			for _, batch := range stream.batches {
+				for _, p := range batch {
+					seen[p.Slug] = true
+				}
+			}
+			r.Len(seen, test.ports)
+			r.Equal(test.first, stream.batches[0][0].Slug)
 		})
 	}
-}
\ No newline at end of file
+}
+
+// upsertStream sends the requests to StreamUpsertPorts and keeps its response
+type upsertStream struct {
+	grpc.ServerStream
+	reqs []*pb.StreamUpsertPortsRequest
+	res  *pb.StreamUpsertPortsResponse
+}
+
+func (s *upsertStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *upsertStream) Recv() (*pb.StreamUpsertPortsRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+
+	return req, nil
+}
+
+func (s *upsertStream) SendAndClose(res *pb.StreamUpsertPortsResponse) error {
+	s.res = res
+	return nil
+}
+
+// loaderStore keeps the loader the import was started with and whether it was aborted
+type loaderStore struct {
+	*MemStore
+	loader  ImportLoader
+	aborted bool
+}
+
+func (s *loaderStore) StartImport(ctx context.Context, loader ImportLoader) (string, error) {
+	s.loader = loader
+	return s.MemStore.StartImport(ctx, loader)
+}
+
+func (s *loaderStore) AbortImport(ctx context.Context, importID string) error {
+	s.aborted = true
+	return s.MemStore.AbortImport(ctx, importID)
+}
+
+func TestService_StreamUpsertPorts(t *testing.T) {
+	rotterdam := &pb.Port{Slug: "NLRTM", Name: "Rotterdam"}
+	hamburg := &pb.Port{Slug: "DEHAM", Name: "Hamburg"}
+	badLat := &pb.Port{Slug: "BEANR", Coordinates: &pb.Coordinates{Lat: 91}}
+
+	cases := []struct {
+		name    string
+		reqs    []*pb.StreamUpsertPortsRequest
+		res     *pb.StreamUpsertPortsResponse
+		failed  []int32
+		ports   int
+		loader  ImportLoader
+		aborted bool
+	}{
+		{
+			name: "upsert",
+			reqs: []*pb.StreamUpsertPortsRequest{
+				{Data: []*pb.Port{rotterdam}},
+				{Data: []*pb.Port{badLat, hamburg}},
+			},
+			res:    &pb.StreamUpsertPortsResponse{Created: 2, Received: 3, Failed: 1},
+			failed: []int32{1},
+			ports:  2,
+		},
+		{
+			name: "dry run",
+			reqs: []*pb.StreamUpsertPortsRequest{
+				{DryRun: true, Data: []*pb.Port{rotterdam, hamburg}},
+			},
+			res: &pb.StreamUpsertPortsResponse{Created: 2, Received: 2, DryRun: true},
+		},
+		{
+			name: "copy loader",
+			reqs: []*pb.StreamUpsertPortsRequest{
+				{Loader: pb.ImportLoader_IMPORT_LOADER_COPY, Data: []*pb.Port{rotterdam}},
+				{Data: []*pb.Port{hamburg}},
+			},
+			res:    &pb.StreamUpsertPortsResponse{Created: 2, Received: 2},
+			ports:  2,
+			loader: LoaderCopy,
+		},
+		{
+			name: "sync with failed port",
+			reqs: []*pb.StreamUpsertPortsRequest{
+				{Mode: pb.ImportMode_IMPORT_MODE_SYNC, Data: []*pb.Port{rotterdam}},
+				{Data: []*pb.Port{hamburg, badLat}},
+			},
+			res:     &pb.StreamUpsertPortsResponse{Received: 3, Failed: 1, Aborted: true},
+			failed:  []int32{2},
+			aborted: true,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			store := &loaderStore{MemStore: NewMemStore()}
+
+			stream := &upsertStream{reqs: test.reqs}
+			r.NoError(NewPortsService(store, nil).StreamUpsertPorts(stream))
+
+			var failed []int32
+			for _, result := range stream.res.FailedPorts {
+				failed = append(failed, result.Index)
+			}
+			r.Equal(test.failed, failed)
+
+			stream.res.FailedPorts = nil
+			r.True(proto.Equal(test.res, stream.res), stream.res.String())
+
+			ports, err := store.List(context.Background(), ListQuery{Limit: 10})
+			r.NoError(err)
+			r.Len(ports, test.ports)
+			r.Equal(test.loader, store.loader)
+			r.Equal(test.aborted, store.aborted)
+		})
+	}
+}
+
+func TestDomainValidator(t *testing.T) {
+	cases := []struct {
+		name     string
+		port     PortEntry
+		warnings []FieldError
+	}{
+		{
+			name: "valid port",
+			port: PortEntry{Slug: "NLRTM", Country: "Netherlands", Timezone: "Europe/Amsterdam", Unlocks: []string{"NLRTM"}},
+		},
+		{
+			name: "country code and alias",
+			port: PortEntry{Slug: "KNBAS", Country: "St. Kitts & Nevis", Unlocks: []string{"KNBAS"}},
+		},
+		{
+			name: "country name without diacritics",
+			port: PortEntry{Slug: "CWWIL", Country: "Curacao", Timezone: "America/Curacao"},
+		},
+		{
+			name:     "unknown country",
+			port:     PortEntry{Slug: "XXABC", Country: "Atlantis"},
+			warnings: []FieldError{{Field: "country", Reason: "value must be an ISO 3166 country code or name", Rule: "iso3166"}},
+		},
+		{
+			name: "unknown time zone",
+			port: PortEntry{Slug: "NLRTM", Country: "NL", Timezone: "Asia/Nowhere"},
+			warnings: []FieldError{
+				{Field: "timezone", Reason: "value must be an IANA time zone", Rule: "iana_tz"},
+			},
+		},
+		{
+			name: "invalid unlocks",
+			port: PortEntry{Slug: "NLRTM", Country: "NL", Unlocks: []string{"NLRTM", "XXRTM", "nlrtm", "NLRT1"}},
+			warnings: []FieldError{
+				{Field: "unlocks[1]", Reason: "value must be a UN/LOCODE of two letters of the country and three of the location", Rule: "unlocode"},
+				{Field: "unlocks[2]", Reason: "value must be a UN/LOCODE of two letters of the country and three of the location", Rule: "unlocode"},
+				{Field: "unlocks[3]", Reason: "value must be a UN/LOCODE of two letters of the country and three of the location", Rule: "unlocode"},
+			},
+		},
+		{
+			// there is no code list to tell the locations are unknown, so only their shape is checked
+			name: "unknown unlocks",
+			port: PortEntry{Slug: "NLRTM", Country: "NL", Unlocks: []string{"NLEUR", "NLZZZ", "BEANR", "DEABC"}},
+		},
+		{
+			name:     "slug of other country",
+			port:     PortEntry{Slug: "BEANR", Country: "Netherlands"},
+			warnings: []FieldError{{Field: "slug", Reason: "value must start with the country code NL", Rule: "country_prefix"}},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			errs, warnings := NewDomainValidator(ValidationLenient, DefaultDomainRules...).Check(test.port)
+			r.Empty(errs)
+			r.Equal(test.warnings, warnings)
+
+			errs, warnings = NewDomainValidator(ValidationStrict, DefaultDomainRules...).Check(test.port)
+			r.Equal(test.warnings, errs)
+			r.Empty(warnings)
+		})
+	}
+}
+
+func TestNewUnlocksRule(t *testing.T) {
+	list, err := locode.ReadCodes(strings.NewReader(`,"NL",,".NETHERLANDS",".NETHERLANDS",,,,,,,
+,"NL","ZZZ","Zuidzee","Zuidzee",,"1-------",,,,,
+X,"NL","OLD","Oldport","Oldport",,"1-------",,,,,
+`))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		unlocks []string
+		invalid []string
+	}{
+		{name: "location of the code list", unlocks: []string{"NLZZZ"}},
+		{name: "location missing from the code list", unlocks: []string{"NLRTM"}, invalid: []string{"unlocks[0]"}},
+		{name: "removed location", unlocks: []string{"NLOLD"}, invalid: []string{"unlocks[0]"}},
+		{name: "malformed", unlocks: []string{"NLZZZ", "NL"}, invalid: []string{"unlocks[1]"}},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			var invalid []string
+			for _, fe := range NewUnlocksRule(list)(PortEntry{Unlocks: test.unlocks}) {
+				invalid = append(invalid, fe.Field)
+			}
+
+			require.Equal(t, test.invalid, invalid)
+		})
+	}
+}
+
+func TestService_CreatePortDomain(t *testing.T) {
+	req := &pb.CreatePortRequest{Data: &pb.Port{Slug: "NLRTM", Country: "Netherlands", Timezone: "Asia/Nowhere"}}
+
+	t.Run("lenient", func(t *testing.T) {
+		r := require.New(t)
+		service := Service{store: NewMemStore(), domain: NewDomainValidator(ValidationLenient, DefaultDomainRules...)}
+
+		res, err := service.CreatePort(context.Background(), req)
+		r.NoError(err)
+		r.Equal("NLRTM", res.Data.Slug)
+		r.Len(res.Warnings, 1)
+		r.Equal("timezone", res.Warnings[0].Field)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		r := require.New(t)
+		service := Service{store: NewMemStore(), domain: NewDomainValidator(ValidationStrict, DefaultDomainRules...)}
+
+		_, err := service.CreatePort(context.Background(), req)
+		r.Equal("invalid port: data.timezone: value must be an IANA time zone", status.Convert(err).Message())
+		requireViolations(t, err, []*pb.FieldError{
+			{Field: "data.timezone", Reason: "value must be an IANA time zone", Rule: "iana_tz"},
+		})
+	})
+}
+
+func TestService_CreateOrUpdatePortBulkDomain(t *testing.T) {
+	ports := []*pb.Port{
+		{Slug: "NLRTM", Country: "Netherlands", Timezone: "Europe/Amsterdam"},
+		{Slug: "BEANR", Country: "Netherlands"},
+	}
+
+	cases := []struct {
+		name    string
+		mode    ValidationMode
+		upserts int
+		failed  []int32
+		warned  []int32
+	}{
+		{name: "lenient", mode: ValidationLenient, upserts: 2, warned: []int32{1}},
+		{name: "strict", mode: ValidationStrict, upserts: 1, failed: []int32{1}},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			valid, _, failed, warned := validatePorts(ports, false, NewDomainValidator(test.mode, DefaultDomainRules...))
+			r.Len(valid, test.upserts)
+
+			var failedIndexes, warnedIndexes []int32
+			for _, f := range failed {
+				r.Equal("BEANR", f.Slug)
+				r.Equal("slug", f.Errors[0].Field)
+				failedIndexes = append(failedIndexes, f.Index)
+			}
+			for _, w := range warned {
+				r.Equal("BEANR", w.Slug)
+				r.Equal("slug", w.Warnings[0].Field)
+				warnedIndexes = append(warnedIndexes, w.Index)
+			}
+			r.Equal(test.failed, failedIndexes)
+			r.Equal(test.warned, warnedIndexes)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package portentries
+
+import (
+	"errors"
+	"fmt"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"strings"
+)
+
+// errInvalidUpdateMask is returned for the update mask path which is not an updatable field of the port
+var errInvalidUpdateMask = errors.New("invalid update mask")
+
+// updatableFields are the fields the update mask may list, the port fields and the coordinates on their own
+var updatableFields = append(append([]portField{}, portFields...),
+	portField{
+		name: "coordinates.lat",
+		set:  func(dst *PortEntry, src PortEntry) { dst.Latitude = src.Latitude },
+	},
+	portField{
+		name: "coordinates.lng",
+		set:  func(dst *PortEntry, src PortEntry) { dst.Longitude = src.Longitude },
+	},
+)
+
+// applyUpdateMask sets the fields of the port listed in the mask to their values in data,
+// the listed fields missing from data are cleared
+func applyUpdateMask(port *PortEntry, data *pb.PortUpdatable, paths []string) error {
+	fields := make([]portField, 0, len(paths))
+	for _, path := range paths {
+		field, ok := updatableField(path)
+		if !ok {
+			return fmt.Errorf("%w path %q: value must be one of %s", errInvalidUpdateMask, path, strings.Join(updatableFieldNames(), ", "))
+		}
+		fields = append(fields, field)
+	}
+
+	update := pbUpdatableToModel(data)
+	for _, f := range fields {
+		f.set(port, update)
+	}
+
+	return nil
+}
+
+// applyUpdatable sets the fields of the port which are set in data, empty values are left untouched
+func applyUpdatable(port *PortEntry, data *pb.PortUpdatable) {
+	if data.Code != nil {
+		port.Code = data.Code.Value
+	}
+
+	if data.Unlocks != nil {
+		port.Unlocks = data.Unlocks
+	}
+
+	if data.Timezone != nil {
+		port.Timezone = data.Timezone.Value
+	}
+
+	if data.Coordinates != nil {
+		port.Longitude = data.Coordinates.Lng
+		port.Latitude = data.Coordinates.Lat
+	}
+
+	if data.Regions != nil {
+		port.Regions = data.Regions
+	}
+
+	if data.Alias != nil {
+		port.Alias = data.Alias
+	}
+
+	if data.Country != nil {
+		port.Country = data.Country.Value
+	}
+
+	if data.Province != nil {
+		port.Province = data.Province.Value
+	}
+
+	if data.City != nil {
+		port.City = data.City.Value
+	}
+
+	if data.Name != nil {
+		port.Name = data.Name.Value
+	}
+}
+
+func updatableField(path string) (portField, bool) {
+	for _, f := range updatableFields {
+		if f.name == path {
+			return f, true
+		}
+	}
+
+	return portField{}, false
+}
+
+func updatableFieldNames() []string {
+	names := make([]string, 0, len(updatableFields))
+	for _, f := range updatableFields {
+		names = append(names, f.name)
+	}
+
+	return names
+}
+
+func pbUpdatableToModel(proto *pb.PortUpdatable) PortEntry {
+	pe := PortEntry{
+		Code:     proto.Code.GetValue(),
+		Name:     proto.Name.GetValue(),
+		City:     proto.City.GetValue(),
+		Province: proto.Province.GetValue(),
+		Country:  proto.Country.GetValue(),
+		Alias:    proto.Alias,
+		Regions:  proto.Regions,
+		Timezone: proto.Timezone.GetValue(),
+		Unlocks:  proto.Unlocks,
+	}
+
+	if proto.Coordinates != nil {
+		pe.Latitude = proto.Coordinates.Lat
+		pe.Longitude = proto.Coordinates.Lng
+	}
+
+	return pe
+}
@@ -0,0 +1,217 @@
+package portentries
+
+import (
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"unicode"
+)
+
+// validationError is implemented by the errors of protoc-gen-validate Validate methods
+type validationError interface {
+	error
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the errors of protoc-gen-validate ValidateAll methods, one per violation
+type multiError interface {
+	error
+	AllErrors() []error
+}
+
+// the rules of the errors the service finds on its own
+const (
+	ruleRequired = "required"
+	ruleUnique   = "unique"
+	ruleConflict = "conflict"
+	ruleInvalid  = "invalid"
+)
+
+// validationRules name the rules of the proto definition by the reasons of their violations, the validation errors
+// carry no rule on their own, the longer prefixes go first
+var validationRules = []struct {
+	prefix string
+	rule   string
+}{
+	{prefix: "value is required", rule: ruleRequired},
+	{prefix: "value length must be at least", rule: "min_len"},
+	{prefix: "value length must be at most", rule: "max_len"},
+	{prefix: "value length must be between", rule: "len_range"},
+	{prefix: "value length must be", rule: "len"},
+	{prefix: "value does not match regex pattern", rule: "pattern"},
+	{prefix: "value must be one of the defined enum values", rule: "defined_only"},
+	{prefix: "value must be in list", rule: "in"},
+	{prefix: "value must be inside range", rule: "range"},
+	{prefix: "value must be greater than or equal to", rule: "gte"},
+	{prefix: "value must be greater than", rule: "gt"},
+	{prefix: "value must be less than or equal to", rule: "lte"},
+	{prefix: "value must be less than", rule: "lt"},
+	{prefix: "value must contain at least", rule: "min_items"},
+	{prefix: "value must contain no more than", rule: "max_items"},
+}
+
+// validatePorts validates every port on its own and returns the valid ones with their positions in the request
+// along with the results of the invalid ones, with rejectDuplicates the repeated slugs fail as well,
+// the valid ports breaking the domain rules in lenient mode are returned with their warnings in warned
+func validatePorts(protos []*pb.Port, rejectDuplicates bool, domain *DomainValidator) ([]PortEntry, []int, []*pb.PortResult, []*pb.PortResult) {
+	var (
+		valid   = make([]PortEntry, 0, len(protos))
+		indexes = make([]int, 0, len(protos))
+		failed  []*pb.PortResult
+		warned  []*pb.PortResult
+		seen    = map[string]struct{}{}
+	)
+
+	for i, proto := range protos {
+		var (
+			errs     []*pb.FieldError
+			warnings []FieldError
+		)
+		switch err := proto.ValidateAll(); {
+		case proto == nil:
+			errs = []*pb.FieldError{{Field: "data", Reason: "value is required", Rule: ruleRequired}}
+		case err != nil:
+			errs = fieldErrors(err)
+		case rejectDuplicates:
+			if _, ok := seen[proto.Slug]; ok {
+				errs = []*pb.FieldError{{Field: "slug", Reason: "value is repeated in the request", Rule: ruleUnique}}
+			}
+			seen[proto.Slug] = struct{}{}
+		}
+
+		if len(errs) == 0 {
+			var broken []FieldError
+			broken, warnings = domain.Check(pbPortToModel(proto))
+			errs = fieldErrorsToPB(broken)
+		}
+
+		if len(errs) > 0 {
+			failed = append(failed, &pb.PortResult{
+				Index:  int32(i),
+				Slug:   proto.GetSlug(),
+				Status: pb.PortResult_FAILED,
+				Errors: errs,
+			})
+			continue
+		}
+
+		if len(warnings) > 0 {
+			warned = append(warned, &pb.PortResult{
+				Index:    int32(i),
+				Slug:     proto.Slug,
+				Warnings: fieldErrorsToPB(warnings),
+			})
+		}
+
+		valid = append(valid, pbPortToModel(proto))
+		indexes = append(indexes, i)
+	}
+
+	return valid, indexes, failed, warned
+}
+
+// checkPort checks the port with the domain rules, in strict mode the broken rules fail the request
+// with the fields named under prefix, in lenient mode they are returned as warnings
+func checkPort(domain *DomainValidator, port PortEntry, prefix string) ([]*pb.FieldError, error) {
+	errs, warnings := domain.Check(port)
+	if len(errs) == 0 {
+		return fieldErrorsToPB(warnings), nil
+	}
+
+	violations := fieldErrorsToPB(errs)
+	reasons := make([]string, 0, len(violations))
+	for _, v := range violations {
+		v.Field = prefix + v.Field
+		reasons = append(reasons, v.Field+": "+v.Reason)
+	}
+
+	return nil, badRequestError("invalid port: "+strings.Join(reasons, ", "), violations)
+}
+
+// fieldErrors turns every violation of the validation error into the path of the invalid field,
+// like coordinates.lat, the reason and the rule it breaks
+func fieldErrors(err error) []*pb.FieldError {
+	return pathFieldErrors(nil, err)
+}
+
+// pathFieldErrors collects the violations of the error found in the field under path
+func pathFieldErrors(path []string, err error) []*pb.FieldError {
+	if multi, ok := err.(multiError); ok {
+		var errs []*pb.FieldError
+		for _, e := range multi.AllErrors() {
+			errs = append(errs, pathFieldErrors(path, e)...)
+		}
+
+		return errs
+	}
+
+	verr, ok := err.(validationError)
+	if !ok {
+		return []*pb.FieldError{{Field: strings.Join(path, "."), Reason: err.Error(), Rule: ruleInvalid}}
+	}
+	path = append(path[:len(path):len(path)], snakeCase(verr.Field()))
+
+	switch verr.Cause().(type) {
+	case validationError, multiError:
+		return pathFieldErrors(path, verr.Cause())
+	}
+
+	reason := verr.Reason()
+	if verr.Cause() != nil {
+		reason += ": " + verr.Cause().Error()
+	}
+
+	return []*pb.FieldError{{Field: strings.Join(path, "."), Reason: reason, Rule: validationRule(verr.Reason())}}
+}
+
+// validationRule names the rule of the proto definition by the reason of its violation
+func validationRule(reason string) string {
+	for _, r := range validationRules {
+		if strings.HasPrefix(reason, r.prefix) {
+			return r.rule
+		}
+	}
+
+	return ruleInvalid
+}
+
+// badRequestError attaches the invalid fields as the BadRequest details of the status,
+// so the clients can point to them without parsing the message, FieldErrors details add the rules they break
+func badRequestError(message string, errs []*pb.FieldError) error {
+	st := status.New(codes.InvalidArgument, message)
+
+	details := &errdetails.BadRequest{}
+	for _, fe := range errs {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fe.Field,
+			Description: fe.Reason,
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(details, &pb.FieldErrors{Errors: errs})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// snakeCase turns go field names the validation errors use into the proto field names, Alias[1] becomes alias[1]
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && unicode.IsLower(rune(name[i-1])) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
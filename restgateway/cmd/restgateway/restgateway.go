@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/go-chi/chi"
+	"github.com/kreyyser/transshipment/common/actor"
 	"github.com/kreyyser/transshipment/common/config"
 	"github.com/kreyyser/transshipment/common/router"
 	"github.com/kreyyser/transshipment/restgateway/internal/ports"
@@ -13,6 +14,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 )
@@ -85,10 +87,22 @@ func initServer(c *config.ConfigManager) error {
 		return err
 	}
 
+	// the uploads wait in the imports dir for their jobs, which are resumed from it after the restart,
+	// so it must outlive the gateway unlike the temp dir
+	importsDir := rg.Options["imports_dir"]
+	if importsDir == "" {
+		return errors.New("restgateway config misses imports_dir option, the persistent dir of the uploaded files")
+	}
+
 	var conn *grpc.ClientConn
 	for i := 0; i < 3; i++ {
 		var conErr error
-		conn, conErr = grpc.Dial(fmt.Sprintf("%s%s", "ports", pc.Address), grpc.WithInsecure())
+		conn, conErr = grpc.Dial(
+			fmt.Sprintf("%s%s", "ports", pc.Address),
+			grpc.WithInsecure(),
+			grpc.WithUnaryInterceptor(actor.UnaryClientInterceptor),
+			grpc.WithStreamInterceptor(actor.StreamClientInterceptor),
+		)
 		if conErr != nil && i == 2{
 			return err
 		}
@@ -100,9 +114,44 @@ func initServer(c *config.ConfigManager) error {
 		time.Sleep(time.Second * 2)
 	}
 
+	ctx, cncl := signaledCtx()
+	defer cncl()
+
+	uploadExpiry := 24 * time.Hour
+	if value := rg.Options["upload_expiry"]; value != "" {
+		if uploadExpiry, err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid upload_expiry option: %s", err)
+		}
+	}
+
+	maxUploadSize := int64(ports.MaxUploadSize)
+	if value := rg.Options["max_upload_size"]; value != "" {
+		if maxUploadSize, err = strconv.ParseInt(value, 10, 64); err != nil || maxUploadSize <= 0 {
+			return fmt.Errorf("invalid max_upload_size option, should be the positive number of bytes: %s", value)
+		}
+	}
+
+	portSrv, err := ports.NewServer(ctx, conn, importsDir, uploadExpiry, maxUploadSize)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// import jobs stop once the context is done, they are resumed on the next start
+		cncl()
+		portSrv.WaitImportJobs()
+	}()
+
+	go func() {
+		if err := portSrv.ResumeImportJobs(ctx); err != nil {
+			fmt.Printf("failed to resume import jobs. err: %v\n", err)
+		}
+	}()
+
+	go portSrv.ExpireUploads(ctx)
+
 	routes := [][]router.Route{
 		// TODO a place to add any other modules routes
-		ports.Routes(conn),
+		ports.Routes(portSrv),
 	}
 
 	var apiRoutes []router.Route
@@ -111,6 +160,7 @@ func initServer(c *config.ConfigManager) error {
 	}
 
 	rtr := chi.NewRouter()
+	rtr.Use(actor.HTTPMiddleware)
 
 	for _, rt := range apiRoutes {
 		rtr.MethodFunc(rt.Method, rt.Path, rt.Handler)
@@ -121,9 +171,6 @@ func initServer(c *config.ConfigManager) error {
 		return err
 	}
 
-	ctx, cncl := signaledCtx()
-	defer cncl()
-
 	return runSrvr(ctx, srvr)
 }
 
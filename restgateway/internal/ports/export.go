@@ -0,0 +1,331 @@
+package ports
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// exportFormat is the way the exported ports are written
+type exportFormat struct {
+	contentType string
+	extension   string
+	newWriter   func(w io.Writer) portsWriter
+}
+
+// exportFormats are the formats of GET /ports/export, each of them is read back by the upload
+var exportFormats = map[string]exportFormat{
+	"object":  {contentType: "application/json", extension: "json", newWriter: newObjectWriter},
+	"ndjson":  {contentType: "application/x-ndjson", extension: "ndjson", newWriter: newNDJSONWriter},
+	"csv":     {contentType: "text/csv; charset=utf-8", extension: "csv", newWriter: newCSVWriter},
+	"geojson": {contentType: "application/geo+json", extension: "geojson", newWriter: newGeoJSONWriter},
+}
+
+// exportHeader are the columns of the csv export
+var exportHeader = []string{
+	"slug", "name", "city", "province", "country", "alias", "regions",
+	"latitude", "longitude", "timezone", "unlocks", "code",
+}
+
+// ExportPort is the port the way the upload reads it
+type ExportPort struct {
+	Slug        string    `json:"slug,omitempty"`
+	Name        string    `json:"name"`
+	City        string    `json:"city"`
+	Province    string    `json:"province"`
+	Country     string    `json:"country"`
+	Alias       []string  `json:"alias"`
+	Regions     []string  `json:"regions"`
+	Coordinates []float64 `json:"coordinates,omitempty"`
+	Timezone    string    `json:"timezone"`
+	Unlocks     []string  `json:"unlocks"`
+	Code        string    `json:"code"`
+}
+
+// ExportPorts streams every port matching the filters of GET /ports in the format of the upload,
+// format query parameter is one of object (default), ndjson, csv or geojson
+func (s *PortServer) ExportPorts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	name := query.Get("format")
+	if name == "" {
+		name = "object"
+	}
+
+	format, ok := exportFormats[name]
+	if !ok {
+		respondBadRequest(fmt.Sprintf("wrong format provided: %s", name), w)
+		return
+	}
+
+	list, err := listRequestFromQuery(query)
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	stream, err := s.portsClient.ExportPorts(r.Context(), &pb.ExportPortsRequest{
+		Country:    list.Country,
+		Province:   list.Province,
+		Region:     list.Region,
+		Timezone:   list.Timezone,
+		CodePrefix: list.CodePrefix,
+		OrderBy:    list.OrderBy,
+		Descending: list.Descending,
+		AsOf:       list.AsOf,
+	})
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	// the first batch tells whether the export fails before the response is started
+	res, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		respondGRPCError(err, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"ports.%s\"", format.extension))
+
+	buf := bufio.NewWriter(w)
+	ports := format.newWriter(buf)
+	flusher, _ := w.(http.Flusher)
+
+	for err == nil {
+		for _, port := range res.Data {
+			if err := ports.write(toExportPort(port)); err != nil {
+				fmt.Printf("error writing ports export. err: %v\n", err)
+				return
+			}
+		}
+
+		// every batch reaches the client as soon as it is written
+		if err := buf.Flush(); err != nil {
+			fmt.Printf("error writing ports export. err: %v\n", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		res, err = stream.Recv()
+	}
+
+	if err != io.EOF {
+		// the response is already started, the export is cut short so the client doesn't take it as complete
+		fmt.Printf("error receiving ports export. err: %v\n", err)
+		panic(http.ErrAbortHandler)
+	}
+
+	if err := ports.close(); err != nil {
+		fmt.Printf("error writing ports export. err: %v\n", err)
+		return
+	}
+
+	if err := buf.Flush(); err != nil {
+		fmt.Printf("error writing ports export. err: %v\n", err)
+	}
+}
+
+func toExportPort(proto *pb.Port) ExportPort {
+	port := ExportPort{
+		Slug:     proto.Slug,
+		Name:     proto.Name,
+		City:     proto.City,
+		Province: proto.Province,
+		Country:  proto.Country,
+		Alias:    proto.Alias,
+		Regions:  proto.Regions,
+		Timezone: proto.Timezone,
+		Unlocks:  proto.Unlocks,
+		Code:     proto.Code,
+	}
+
+	if proto.Coordinates != nil {
+		port.Coordinates = []float64{proto.Coordinates.Lng, proto.Coordinates.Lat}
+	}
+
+	return port
+}
+
+// portsWriter writes the exported ports one by one, close ends the file
+type portsWriter interface {
+	write(port ExportPort) error
+	close() error
+}
+
+// objectWriter writes the ports as the object keyed by their slugs
+type objectWriter struct {
+	w     io.Writer
+	count int
+}
+
+func newObjectWriter(w io.Writer) portsWriter {
+	return &objectWriter{w: w}
+}
+
+func (o *objectWriter) write(port ExportPort) error {
+	key, err := json.Marshal(port.Slug)
+	if err != nil {
+		return err
+	}
+
+	port.Slug = ""
+	value, err := json.Marshal(port)
+	if err != nil {
+		return err
+	}
+
+	prefix := ",\n  "
+	if o.count == 0 {
+		prefix = "{\n  "
+	}
+	o.count++
+
+	_, err = fmt.Fprintf(o.w, "%s%s: %s", prefix, key, value)
+
+	return err
+}
+
+func (o *objectWriter) close() error {
+	end := "\n}\n"
+	if o.count == 0 {
+		end = "{}\n"
+	}
+
+	_, err := io.WriteString(o.w, end)
+
+	return err
+}
+
+// ndjsonWriter writes the ports with their slugs a line each
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) portsWriter {
+	return &ndjsonWriter{enc: json.NewEncoder(w)}
+}
+
+func (n *ndjsonWriter) write(port ExportPort) error {
+	return n.enc.Encode(port)
+}
+
+func (n *ndjsonWriter) close() error {
+	return nil
+}
+
+// csvWriter writes the ports as rows of the table with exportHeader, lists are joined by semicolons
+type csvWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func newCSVWriter(w io.Writer) portsWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) write(port ExportPort) error {
+	if !c.header {
+		c.header = true
+		if err := c.w.Write(exportHeader); err != nil {
+			return err
+		}
+	}
+
+	var lat, lng string
+	if len(port.Coordinates) == 2 {
+		lng = strconv.FormatFloat(port.Coordinates[0], 'f', -1, 64)
+		lat = strconv.FormatFloat(port.Coordinates[1], 'f', -1, 64)
+	}
+
+	if err := c.w.Write([]string{
+		port.Slug, port.Name, port.City, port.Province, port.Country,
+		strings.Join(port.Alias, ";"), strings.Join(port.Regions, ";"),
+		lat, lng, port.Timezone, strings.Join(port.Unlocks, ";"), port.Code,
+	}); err != nil {
+		return err
+	}
+
+	// the csv writer buffers the rows on its own, they are passed on to be flushed with their batch
+	c.w.Flush()
+
+	return c.w.Error()
+}
+
+func (c *csvWriter) close() error {
+	if !c.header {
+		c.header = true
+		if err := c.w.Write(exportHeader); err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+
+	return c.w.Error()
+}
+
+// geoJSONWriter writes the ports as Point features of the FeatureCollection, the slug is the feature id
+type geoJSONWriter struct {
+	w     io.Writer
+	count int
+}
+
+// geoJSONFeature is the port as the GeoJSON Feature, the port without coordinates has no geometry
+type geoJSONFeature struct {
+	Type       string        `json:"type"`
+	ID         string        `json:"id"`
+	Geometry   *geoJSONPoint `json:"geometry"`
+	Properties ExportPort    `json:"properties"`
+}
+
+type geoJSONPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+func newGeoJSONWriter(w io.Writer) portsWriter {
+	return &geoJSONWriter{w: w}
+}
+
+func (g *geoJSONWriter) write(port ExportPort) error {
+	feature := geoJSONFeature{Type: "Feature", ID: port.Slug, Properties: port}
+	if len(port.Coordinates) == 2 {
+		feature.Geometry = &geoJSONPoint{Type: "Point", Coordinates: port.Coordinates}
+		feature.Properties.Coordinates = nil
+	}
+
+	value, err := json.Marshal(feature)
+	if err != nil {
+		return err
+	}
+
+	prefix := ",\n  "
+	if g.count == 0 {
+		prefix = "{\"type\": \"FeatureCollection\", \"features\": [\n  "
+	}
+	g.count++
+
+	_, err = fmt.Fprintf(g.w, "%s%s", prefix, value)
+
+	return err
+}
+
+func (g *geoJSONWriter) close() error {
+	end := "\n]}\n"
+	if g.count == 0 {
+		end = "{\"type\": \"FeatureCollection\", \"features\": []}\n"
+	}
+
+	_, err := io.WriteString(g.w, end)
+
+	return err
+}
@@ -0,0 +1,192 @@
+package ports
+
+import (
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// exportStreamMock returns the batches one by one and err after them, io.EOF when err is nil
+type exportStreamMock struct {
+	grpc.ClientStream
+	batches [][]*pb.Port
+	err     error
+}
+
+func (m *exportStreamMock) Recv() (*pb.ExportPortsResponse, error) {
+	if len(m.batches) == 0 {
+		if m.err != nil {
+			return nil, m.err
+		}
+		return nil, io.EOF
+	}
+
+	batch := m.batches[0]
+	m.batches = m.batches[1:]
+
+	return &pb.ExportPortsResponse{Data: batch}, nil
+}
+
+// exportedPorts are the ports of the export golden files, the second one needs quoting in csv
+func exportedPorts() [][]*pb.Port {
+	return [][]*pb.Port{
+		{
+			{
+				Slug: "NLRTM", Name: "Rotterdam", City: "Rotterdam", Province: "Zuid-Holland", Country: "Netherlands",
+				Alias: []string{"Europoort"}, Regions: []string{"Europe"}, Coordinates: &pb.Coordinates{Lat: 51.9225, Lng: 4.47917},
+				Timezone: "Europe/Amsterdam", Unlocks: []string{"NLRTM", "NLEUR"}, Code: "42",
+			},
+		},
+		{
+			{
+				Slug: "USNYC", Name: `New York, "The Big Apple"`, City: "New York", Province: "NY", Country: "United States",
+				Alias: []string{"NYC", "New York\nNew Jersey"}, Unlocks: []string{"USNYC"},
+			},
+		},
+	}
+}
+
+func TestPortServer_ExportPorts(t *testing.T) {
+	cases := []struct {
+		format      string
+		contentType string
+		fileName    string
+		ports       [][]*pb.Port
+		body        string
+	}{
+		{
+			format:      "",
+			contentType: "application/json",
+			fileName:    "ports.json",
+			ports:       exportedPorts(),
+			body: `{
+  "NLRTM": {"name":"Rotterdam","city":"Rotterdam","province":"Zuid-Holland","country":"Netherlands","alias":["Europoort"],"regions":["Europe"],"coordinates":[4.47917,51.9225],"timezone":"Europe/Amsterdam","unlocks":["NLRTM","NLEUR"],"code":"42"},
+  "USNYC": {"name":"New York, \"The Big Apple\"","city":"New York","province":"NY","country":"United States","alias":["NYC","New York\nNew Jersey"],"regions":null,"timezone":"","unlocks":["USNYC"],"code":""}
+}
+`,
+		},
+		{
+			format:      "object",
+			contentType: "application/json",
+			fileName:    "ports.json",
+			body:        "{}\n",
+		},
+		{
+			format:      "ndjson",
+			contentType: "application/x-ndjson",
+			fileName:    "ports.ndjson",
+			ports:       exportedPorts(),
+			body: `{"slug":"NLRTM","name":"Rotterdam","city":"Rotterdam","province":"Zuid-Holland","country":"Netherlands","alias":["Europoort"],"regions":["Europe"],"coordinates":[4.47917,51.9225],"timezone":"Europe/Amsterdam","unlocks":["NLRTM","NLEUR"],"code":"42"}
+{"slug":"USNYC","name":"New York, \"The Big Apple\"","city":"New York","province":"NY","country":"United States","alias":["NYC","New York\nNew Jersey"],"regions":null,"timezone":"","unlocks":["USNYC"],"code":""}
+`,
+		},
+		{
+			format:      "csv",
+			contentType: "text/csv; charset=utf-8",
+			fileName:    "ports.csv",
+			ports:       exportedPorts(),
+			body: `slug,name,city,province,country,alias,regions,latitude,longitude,timezone,unlocks,code
+NLRTM,Rotterdam,Rotterdam,Zuid-Holland,Netherlands,Europoort,Europe,51.9225,4.47917,Europe/Amsterdam,NLRTM;NLEUR,42
+USNYC,"New York, ""The Big Apple""",New York,NY,United States,"NYC;New York
+New Jersey",,,,,USNYC,
+`,
+		},
+		{
+			format:      "csv",
+			contentType: "text/csv; charset=utf-8",
+			fileName:    "ports.csv",
+			body:        "slug,name,city,province,country,alias,regions,latitude,longitude,timezone,unlocks,code\n",
+		},
+		{
+			format:      "geojson",
+			contentType: "application/geo+json",
+			fileName:    "ports.geojson",
+			ports:       exportedPorts(),
+			body: `{"type": "FeatureCollection", "features": [
+  {"type":"Feature","id":"NLRTM","geometry":{"type":"Point","coordinates":[4.47917,51.9225]},"properties":{"slug":"NLRTM","name":"Rotterdam","city":"Rotterdam","province":"Zuid-Holland","country":"Netherlands","alias":["Europoort"],"regions":["Europe"],"timezone":"Europe/Amsterdam","unlocks":["NLRTM","NLEUR"],"code":"42"}},
+  {"type":"Feature","id":"USNYC","geometry":null,"properties":{"slug":"USNYC","name":"New York, \"The Big Apple\"","city":"New York","province":"NY","country":"United States","alias":["NYC","New York\nNew Jersey"],"regions":null,"timezone":"","unlocks":["USNYC"],"code":""}}
+]}
+`,
+		},
+		{
+			format:      "geojson",
+			contentType: "application/geo+json",
+			fileName:    "ports.geojson",
+			body:        "{\"type\": \"FeatureCollection\", \"features\": []}\n",
+		},
+	}
+
+	for _, test := range cases {
+		name := test.format
+		if name == "" {
+			name = "default"
+		}
+		if len(test.ports) == 0 {
+			name += " empty"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			var exported *pb.ExportPortsRequest
+			rec := serve(t, &portsClientMock{
+				exportPorts: func(req *pb.ExportPortsRequest) (pb.PortsService_ExportPortsClient, error) {
+					exported = req
+					return &exportStreamMock{batches: test.ports}, nil
+				},
+			}, newRequest(http.MethodGet, "/ports/export?country=NL&format="+test.format, "", ""))
+
+			r.Equal(http.StatusOK, rec.Code)
+			r.Equal(test.contentType, rec.Header().Get("Content-Type"))
+			r.Equal(`attachment; filename="`+test.fileName+`"`, rec.Header().Get("Content-Disposition"))
+			r.Equal(test.body, rec.Body.String())
+			r.Equal("NL", exported.Country)
+		})
+	}
+}
+
+func TestPortServer_ExportPortsErrors(t *testing.T) {
+	t.Run("wrong format", func(t *testing.T) {
+		rec := serve(t, &portsClientMock{}, newRequest(http.MethodGet, "/ports/export?format=xml", "", ""))
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("stream fails before the first batch", func(t *testing.T) {
+		r := require.New(t)
+
+		rec := serve(t, &portsClientMock{
+			exportPorts: func(req *pb.ExportPortsRequest) (pb.PortsService_ExportPortsClient, error) {
+				return &exportStreamMock{err: status.Error(codes.Unavailable, "ports service is down")}, nil
+			},
+		}, newRequest(http.MethodGet, "/ports/export?format=csv", "", ""))
+
+		r.Equal(http.StatusServiceUnavailable, rec.Code)
+		r.Equal("application/problem+json", rec.Header().Get("Content-Type"))
+	})
+
+	t.Run("stream fails mid-export", func(t *testing.T) {
+		r := require.New(t)
+
+		srv := newTestServer(t, &portsClientMock{
+			exportPorts: func(req *pb.ExportPortsRequest) (pb.PortsService_ExportPortsClient, error) {
+				return &exportStreamMock{batches: exportedPorts()[:1], err: status.Error(codes.Internal, "connection reset")}, nil
+			},
+		})
+
+		// the response is started, so the handler aborts it and the client sees the export cut short
+		rec := httptest.NewRecorder()
+		r.PanicsWithValue(http.ErrAbortHandler, func() {
+			handle(srv, rec, newRequest(http.MethodGet, "/ports/export?format=object", "", ""))
+		})
+
+		r.Equal(http.StatusOK, rec.Code)
+		r.Equal(`{
+  "NLRTM": {"name":"Rotterdam","city":"Rotterdam","province":"Zuid-Holland","country":"Netherlands","alias":["Europoort"],"regions":["Europe"],"coordinates":[4.47917,51.9225],"timezone":"Europe/Amsterdam","unlocks":["NLRTM","NLEUR"],"code":"42"}`, rec.Body.String())
+	})
+}
@@ -0,0 +1,1024 @@
+package ports
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kreyyser/transshipment/common/actor"
+	"github.com/kreyyser/transshipment/common/csvparser"
+	"github.com/kreyyser/transshipment/common/jsonparser"
+	"github.com/kreyyser/transshipment/common/portfile"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// importProgressInterval limits how often the progress of the running import job is reported
+	importProgressInterval = time.Second
+	// maxFileNameLength is the longest file name the import job keeps
+	maxFileNameLength = 255
+	// maxRecordErrors is the number of record errors the import job keeps
+	maxRecordErrors = 100
+	// maxFormValueLength is the longest value of the upload form besides the file
+	maxFormValueLength = 64 << 10
+)
+
+// errUploadTooLarge tells the uploaded file, decompressed, is larger than the max upload size of the gateway
+var errUploadTooLarge = errors.New("upload is too large")
+
+// importJobStatuses maps import job statuses of the api to the ones shown to the user
+var importJobStatuses = map[pb.ImportJobStatus]string{
+	pb.ImportJobStatus_IMPORT_JOB_STATUS_PENDING:   "pending",
+	pb.ImportJobStatus_IMPORT_JOB_STATUS_RUNNING:   "running",
+	pb.ImportJobStatus_IMPORT_JOB_STATUS_SUCCEEDED: "succeeded",
+	pb.ImportJobStatus_IMPORT_JOB_STATUS_FAILED:    "failed",
+}
+
+// importFormats maps import formats of the api to the portfile ones, which are shown to the user as well
+var importFormats = map[pb.ImportFormat]string{
+	pb.ImportFormat_IMPORT_FORMAT_AUTO:     "",
+	pb.ImportFormat_IMPORT_FORMAT_OBJECT:   "object",
+	pb.ImportFormat_IMPORT_FORMAT_ARRAY:    "array",
+	pb.ImportFormat_IMPORT_FORMAT_NDJSON:   "ndjson",
+	pb.ImportFormat_IMPORT_FORMAT_GEOJSON:  "geojson",
+	pb.ImportFormat_IMPORT_FORMAT_CSV:      "csv",
+	pb.ImportFormat_IMPORT_FORMAT_UNLOCODE: "unlocode",
+}
+
+// conflictPolicies maps conflict policies of the api to the ones shown to the user
+var conflictPolicies = map[pb.ConflictPolicy]string{
+	pb.ConflictPolicy_CONFLICT_POLICY_OVERWRITE:        "overwrite",
+	pb.ConflictPolicy_CONFLICT_POLICY_SKIP_EXISTING:    "skip_existing",
+	pb.ConflictPolicy_CONFLICT_POLICY_FILL_EMPTY_ONLY:  "fill_empty_only",
+	pb.ConflictPolicy_CONFLICT_POLICY_FAIL_ON_CONFLICT: "fail_on_conflict",
+}
+
+// UploadPortsState allows you to upload file with Port instances and create or update existing Port by slug
+// by default it should have a json object structure
+//
+//	{
+//	   "PORT1": {
+//	     ...
+//	   },
+//	   "PORT2": {
+//	     ...
+//	   },
+//	}
+//
+// format=array, ndjson or geojson reads a json array of the ports, the ports one per line or a GeoJSON
+// FeatureCollection of Point features with the ports as their properties, the format is detected when it is not set
+// format=csv reads a csv file with the header naming the port fields, files named *.csv are read so by default,
+// format=unlocode reads the ports of the UN/LOCODE code list csv with the country and location code as the slug
+// slug_property is the property or column of the ports the slug is read from, slug by default
+// the file may be gzip compressed, or a zip archive which ports files, like *.json or *.csv.gz, are imported in the same job,
+// the request compressed as a whole is read with Content-Encoding: gzip
+// mode=sync makes the db match the file exactly by deleting ports missing from it, mode=upsert (default) keeps them
+// dry_run=true only reports how many ports would be created, updated, unchanged and removed
+// loader=copy loads the ports with COPY, which is much faster for the whole dataset, loader=insert (default) with INSERT
+// conflict_policy tells how the stored ports are changed, one of overwrite (default), skip_existing, fill_empty_only
+// or fail_on_conflict, field_policies override it for single fields, like timezone:skip_existing,coordinates:fill_empty_only
+// the file is imported in the background by the import job, the response is 202 with the job
+// and its url in the Location header, the file is applied at once, so either all of it or nothing is stored
+func (s *PortServer) UploadPortsState(w http.ResponseWriter, r *http.Request) {
+	//go mem.PrintUsage()
+	body := io.Reader(r.Body)
+	if strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			respondBadRequest(fmt.Sprintf("invalid gzip body: %s", err), w)
+			return
+		}
+		defer func() { _ = gz.Close() }()
+
+		// the form is read from the decompressed body
+		body = gz
+		r.ContentLength = -1
+		r.Header.Del("Content-Encoding")
+	}
+
+	// the decompressed body is limited as well, so a small gzip body can't fill the disk
+	limited := &limitedReader{r: body, n: s.maxUploadSize}
+	r.Body = ioutil.NopCloser(limited)
+
+	spooled, fileName, err := s.readImportForm(r)
+	if err != nil {
+		if limited.exceeded {
+			respondStatus(http.StatusRequestEntityTooLarge, fmt.Sprintf("upload is larger than %d bytes", s.maxUploadSize), w)
+			return
+		}
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	created := false
+	defer func() {
+		if !created {
+			_ = os.Remove(spooled)
+		}
+	}()
+
+	mode, dryRun, err := parseImportOptions(r)
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	format, err := parseImportFormat(r.FormValue("format"))
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	loader, err := parseImportLoader(r.FormValue("loader"))
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	conflicts, err := parseConflicts(r.FormValue("conflict_policy"), r.FormValue("field_policies"))
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	job, err := s.createImportJob(r.Context(), spooled, &pb.CreateImportJobRequest{
+		Mode:         mode,
+		DryRun:       dryRun,
+		FileName:     uploadFileName(fileName),
+		Format:       format,
+		SlugProperty: strings.TrimSpace(r.FormValue("slug_property")),
+		Loader:       loader,
+		Conflicts:    conflicts,
+	})
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+	created = true
+
+	respondImportJob(job, w)
+}
+
+// readImportForm streams the multipart form of the upload, the file part is spooled as it is read,
+// the other parts are the form values of the request, they come before the query ones like in the parsed form
+func (s *PortServer) readImportForm(r *http.Request) (string, string, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return "", "", err
+	}
+
+	form := url.Values{}
+	spooled, fileName := "", ""
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = removeSpool(spooled)
+			return "", "", err
+		}
+
+		name := part.FormName()
+		switch {
+		case name == "file" && spooled != "":
+			err = errors.New("only one file can be uploaded")
+		case name == "file":
+			fileName = part.FileName()
+			spooled, err = s.spoolUpload(part)
+		case name != "":
+			var value []byte
+			value, err = ioutil.ReadAll(io.LimitReader(part, maxFormValueLength+1))
+			if err == nil && len(value) > maxFormValueLength {
+				err = fmt.Errorf("form value %s is longer than %d bytes", name, maxFormValueLength)
+			}
+			form.Add(name, string(value))
+		}
+		_ = part.Close()
+
+		if err != nil {
+			_ = removeSpool(spooled)
+			return "", "", err
+		}
+	}
+
+	if spooled == "" {
+		return "", "", http.ErrMissingFile
+	}
+
+	for name, values := range r.URL.Query() {
+		form[name] = append(form[name], values...)
+	}
+	// the form is set, so FormValue doesn't parse the consumed body again
+	r.Form = form
+
+	return spooled, fileName, nil
+}
+
+// removeSpool removes the spooled upload, if there is one
+func removeSpool(spooled string) error {
+	if spooled == "" {
+		return nil
+	}
+
+	return os.Remove(spooled)
+}
+
+// limitedReader reads up to n bytes and fails after them, exceeded tells it did,
+// the error itself is lost in the errors of the multipart reader
+type limitedReader struct {
+	r        io.Reader
+	n        int64
+	exceeded bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	// one byte more than left tells the body is too large
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+
+	n, err := l.r.Read(p)
+	if int64(n) <= l.n {
+		l.n -= int64(n)
+		return n, err
+	}
+
+	n = int(l.n)
+	l.n = 0
+	l.exceeded = true
+
+	return n, errUploadTooLarge
+}
+
+// createImportJob creates the import job of the spooled file and starts it, the file is moved to the job,
+// it is left where it is when the job can't be created
+func (s *PortServer) createImportJob(ctx context.Context, spooled string, req *pb.CreateImportJobRequest) (*pb.ImportJob, error) {
+	res, err := s.portsClient.CreateImportJob(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(spooled, s.spoolPath(res.Data.Id)); err != nil {
+		s.failImportJob(res.Data.Id, err)
+		return nil, err
+	}
+
+	s.startImportJob(res.Data)
+
+	return res.Data, nil
+}
+
+// respondImportJob responds with 202 and the started import job, the Location header is the url of the job
+func respondImportJob(job *pb.ImportJob, w http.ResponseWriter) {
+	w.Header().Set("Location", fmt.Sprintf("/imports/%d", job.Id))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(fromPbImportJob(job)); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+	}
+}
+
+// GetImportJob returns the import job with its progress
+func (s *PortServer) GetImportJob(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondBadRequest("wrong import job id provided", w)
+		return
+	}
+
+	res, err := s.portsClient.GetImportJob(r.Context(), &pb.ImportJobRequest{Id: id})
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(fromPbImportJob(res.Data)); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+		respondError(err.Error(), w)
+
+		return
+	}
+}
+
+// ListImportJobs returns import jobs, the newest come first
+// supported query parameters are page_size, page_token and status (pending, running, succeeded, failed),
+// status can be repeated or comma separated
+// when there are more jobs to fetch the Link header contains the url of the next page
+func (s *PortServer) ListImportJobs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &pb.ListImportJobsRequest{PageToken: query.Get("page_token")}
+
+	if size := query.Get("page_size"); size != "" {
+		n, err := strconv.ParseInt(size, 10, 32)
+		if err != nil {
+			respondBadRequest(fmt.Sprintf("wrong page_size provided: %s", size), w)
+			return
+		}
+		req.PageSize = int32(n)
+	}
+
+	for _, value := range query["status"] {
+		for _, name := range strings.Split(value, ",") {
+			st, ok := parseImportJobStatus(name)
+			if !ok {
+				respondBadRequest(fmt.Sprintf("wrong status provided: %s", name), w)
+				return
+			}
+			req.Status = append(req.Status, st)
+		}
+	}
+
+	res, err := s.portsClient.ListImportJobs(r.Context(), req)
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	if res.NextPageToken != "" {
+		next := *r.URL
+		query := next.Query()
+		query.Set("page_token", res.NextPageToken)
+		next.RawQuery = query.Encode()
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.RequestURI()))
+	}
+
+	jobs := make([]ImportJob, 0, len(res.Data))
+	for _, v := range res.Data {
+		jobs = append(jobs, fromPbImportJob(v))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(jobs); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+		respondError(err.Error(), w)
+
+		return
+	}
+}
+
+// ResumeImportJobs restarts the import jobs left unfinished by the previous gateway run,
+// the jobs whose uploaded files are gone are failed
+func (s *PortServer) ResumeImportJobs(ctx context.Context) error {
+	req := &pb.ListImportJobsRequest{
+		Status: []pb.ImportJobStatus{
+			pb.ImportJobStatus_IMPORT_JOB_STATUS_PENDING,
+			pb.ImportJobStatus_IMPORT_JOB_STATUS_RUNNING,
+		},
+	}
+
+	for {
+		// the ports service may still be starting along with the gateway
+		res, err := s.portsClient.ListImportJobs(ctx, req, grpc.WaitForReady(true))
+		if err != nil {
+			return err
+		}
+
+		for _, job := range res.Data {
+			if _, err := os.Stat(s.spoolPath(job.Id)); err != nil {
+				s.failImportJob(job.Id, errors.New("uploaded file is lost"))
+				continue
+			}
+
+			fmt.Printf("resuming import job %d\n", job.Id)
+			s.startImportJob(job)
+		}
+
+		if res.NextPageToken == "" {
+			return nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// WaitImportJobs blocks until the running import jobs stop, they stop once the gateway context is done
+func (s *PortServer) WaitImportJobs() {
+	s.jobs.Wait()
+}
+
+// startImportJob runs the import job in the background
+func (s *PortServer) startImportJob(job *pb.ImportJob) {
+	s.jobs.Add(1)
+	go func() {
+		defer s.jobs.Done()
+		s.runImportJob(job)
+	}()
+}
+
+// runImportJob imports the uploaded file of the job and records the outcome,
+// the job interrupted by the gateway shutdown is left as it is to be resumed by the next gateway run
+func (s *PortServer) runImportJob(job *pb.ImportJob) {
+	ctx := actor.NewContext(s.jobsCtx, job.Actor)
+	path := s.spoolPath(job.Id)
+
+	progress := &importProgress{client: s.portsClient, jobID: job.Id}
+	summary, err := s.importFile(ctx, job, path, progress)
+	if ctx.Err() != nil {
+		return
+	}
+
+	final := progress.update(pb.ImportJobStatus_IMPORT_JOB_STATUS_SUCCEEDED)
+	final.Summary = summary
+	if err != nil {
+		final.Status = pb.ImportJobStatus_IMPORT_JOB_STATUS_FAILED
+		final.Errors = append(final.Errors, err.Error())
+	}
+
+	if _, err := s.portsClient.UpdateImportJob(ctx, final); err != nil {
+		fmt.Printf("error finishing import job %d. err: %v", job.Id, err)
+		return
+	}
+
+	if err := os.Remove(path); err != nil {
+		fmt.Printf("error removing import job %d file. err: %v", job.Id, err)
+	}
+}
+
+// importFile streams every port of the file to the ports service, which applies them at once when the file ends,
+// gzip file is decompressed on the fly and every ports file of the zip archive is imported in the same upload,
+// ports which can't be read are counted as failed and the rest of the file is imported anyway
+// unless the job syncs the ports, sync would delete them otherwise
+func (s *PortServer) importFile(ctx context.Context, job *pb.ImportJob, path string, progress *importProgress) (*pb.PortsImportSummary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := portfile.Parts(file, info.Size(), job.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file: %s", err)
+	}
+
+	if job.ImportId != "" {
+		// the import staged by the interrupted run is dropped, the file is imported over again
+		_, err := s.portsClient.AbortPortsImport(ctx, &pb.PortsImportRequest{ImportId: job.ImportId})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+	}
+
+	if _, err := s.portsClient.UpdateImportJob(ctx, progress.update(pb.ImportJobStatus_IMPORT_JOB_STATUS_RUNNING)); err != nil {
+		return nil, err
+	}
+
+	// canceling the upload makes the ports service drop what it has received
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	upload, err := s.portsClient.StreamUpsertPorts(uploadCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the options go first, so even the empty file syncs the ports
+	first := &pb.StreamUpsertPortsRequest{Mode: job.Mode, DryRun: job.DryRun, Loader: job.Loader, Conflicts: job.Conflicts}
+	if err := upload.Send(first); err != nil {
+		return nil, uploadError(upload, err)
+	}
+
+	// sent tells where the sent ports come from, the ports service reports the failed ones by their position in the upload
+	var sent []sentPort
+	for i, part := range parts {
+		if err := s.sendPart(ctx, job, part, upload, progress, func(offset int64) {
+			sent = append(sent, sentPort{part: i, offset: offset})
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if job.Mode == pb.ImportMode_IMPORT_MODE_SYNC && progress.failed > 0 {
+		return nil, fmt.Errorf("sync needs every port of the file, %d ports failed", progress.failed)
+	}
+
+	res, err := upload.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	// record finds the file and the offset of the reported port
+	record := func(result *pb.PortResult) (string, jsonparser.Entry) {
+		var file string
+		entry := jsonparser.Entry{Key: result.Slug}
+		if int(result.Index) < len(sent) {
+			port := sent[result.Index]
+			entry.Offset = port.offset
+			if parts[port.part].Archived {
+				file = parts[port.part].Name
+			}
+		}
+
+		return file, entry
+	}
+
+	for _, result := range res.FailedPorts {
+		file, entry := record(result)
+		progress.fail(file, entry, result.Errors)
+	}
+	// the ports service reports only the first of the failed ports
+	progress.failed += res.Failed - int64(len(res.FailedPorts))
+
+	for _, result := range res.WarnedPorts {
+		file, entry := record(result)
+		progress.warn(file, entry, result.Warnings)
+	}
+	// and only the first of the warned ones
+	progress.warned += res.Warned - int64(len(res.WarnedPorts))
+
+	if res.Aborted {
+		return nil, fmt.Errorf("sync needs every port of the file, %d ports failed", progress.failed)
+	}
+
+	return &pb.PortsImportSummary{
+		Created:   res.Created,
+		Updated:   res.Updated,
+		Unchanged: res.Unchanged,
+		Removed:   res.Removed,
+		DryRun:    res.DryRun,
+	}, nil
+}
+
+// sentPort is the port sent to the ports service, part is the index of the file part it was read from
+type sentPort struct {
+	part   int
+	offset int64
+}
+
+// sendPart reads the ports of the part and sends them to the upload, sent is called with the offset of every sent port
+func (s *PortServer) sendPart(ctx context.Context, job *pb.ImportJob, part portfile.Part, upload pb.PortsService_StreamUpsertPortsClient, progress *importProgress, sent func(offset int64)) error {
+	r, err := part.Open()
+	if err != nil {
+		return fmt.Errorf("invalid file %s: %s", part.Name, err)
+	}
+	defer func() { _ = r.Close() }()
+
+	var file string
+	if part.Archived {
+		file = part.Name
+	}
+
+	stream := portfile.Parse(ctx, r, portfile.Options{
+		Format:       importFormats[job.Format],
+		FileName:     part.Name,
+		SlugProperty: job.SlugProperty,
+		BufferSize:   ParseBufferSize,
+		ChunkSize:    ChunkSize,
+	}, func() interface{} { return new(Port) })
+	defer stream.Close()
+
+	for {
+		entries, ok := stream.Next()
+		if !ok {
+			break
+		}
+
+		ports := make([]Port, 0, len(entries))
+		for _, entry := range entries {
+			progress.processed++
+
+			if entry.Err != nil {
+				progress.fail(file, entry, decodeErrors(entry.Err))
+				continue
+			}
+
+			p := *entry.Value.(*Port)
+			p.Slug = entry.Key
+			ports = append(ports, p)
+			sent(entry.Offset)
+		}
+
+		if len(ports) > 0 {
+			if err := upload.Send(&pb.StreamUpsertPortsRequest{Data: toPbPorts(ports)}); err != nil {
+				return uploadError(upload, err)
+			}
+		}
+
+		if err := progress.report(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if part.Archived {
+			return fmt.Errorf("invalid file %s: %s", part.Name, err)
+		}
+
+		return fmt.Errorf("invalid file: %s", err)
+	}
+
+	return nil
+}
+
+// uploadError gets the error the ports service has ended the upload stream with,
+// Send only tells the stream is closed
+func uploadError(upload pb.PortsService_StreamUpsertPortsClient, err error) error {
+	if err != io.EOF {
+		return err
+	}
+
+	if _, err := upload.CloseAndRecv(); err != nil {
+		return err
+	}
+
+	return errors.New("ports service closed the upload")
+}
+
+// failImportJob marks the job failed with the error
+func (s *PortServer) failImportJob(id int64, cause error) {
+	_, err := s.portsClient.UpdateImportJob(s.jobsCtx, &pb.UpdateImportJobRequest{
+		Id:     id,
+		Status: pb.ImportJobStatus_IMPORT_JOB_STATUS_FAILED,
+		Errors: []string{cause.Error()},
+	})
+	if err != nil {
+		fmt.Printf("error failing import job %d. err: %v", id, err)
+	}
+}
+
+// spoolUpload stores the uploaded file in the imports dir and returns its path
+func (s *PortServer) spoolUpload(file io.Reader) (string, error) {
+	spool, err := ioutil.TempFile(s.importsDir, "upload-*.tmp")
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(spool, file); err != nil {
+		_ = spool.Close()
+		_ = os.Remove(spool.Name())
+		return "", err
+	}
+
+	if err := spool.Close(); err != nil {
+		_ = os.Remove(spool.Name())
+		return "", err
+	}
+
+	return spool.Name(), nil
+}
+
+// spoolPath is the path of the uploaded file of the import job
+func (s *PortServer) spoolPath(jobID int64) string {
+	return filepath.Join(s.importsDir, fmt.Sprintf("%d.json", jobID))
+}
+
+// importProgress counts the ports read by the import job and reports them at most once per importProgressInterval
+type importProgress struct {
+	client     pb.PortsServiceClient
+	jobID      int64
+	processed  int64
+	failed     int64
+	errors     []*pb.RecordError
+	warned     int64
+	warnings   []*pb.RecordError
+	reportedAt time.Time
+}
+
+// fail counts the failed record of the file, empty unless it is in the zip archive,
+// its errors are reported with the next update as long as the job has not collected maxRecordErrors already
+func (p *importProgress) fail(file string, entry jsonparser.Entry, errs []*pb.FieldError) {
+	p.failed++
+	if p.failed > maxRecordErrors {
+		return
+	}
+
+	p.errors = append(p.errors, &pb.RecordError{Key: entry.Key, Offset: entry.Offset, Errors: errs, File: file})
+}
+
+// warn counts the record of the file stored in spite of the domain rules it breaks,
+// its warnings are reported the same as the errors of the failed records
+func (p *importProgress) warn(file string, entry jsonparser.Entry, warnings []*pb.FieldError) {
+	p.warned++
+	if p.warned > maxRecordErrors {
+		return
+	}
+
+	p.warnings = append(p.warnings, &pb.RecordError{Key: entry.Key, Offset: entry.Offset, Errors: warnings, File: file})
+}
+
+// update builds the job update with the progress and the record errors not reported yet
+func (p *importProgress) update(st pb.ImportJobStatus) *pb.UpdateImportJobRequest {
+	update := &pb.UpdateImportJobRequest{
+		Id:             p.jobID,
+		Status:         st,
+		Processed:      p.processed,
+		Failed:         p.failed,
+		RecordErrors:   p.errors,
+		Warned:         p.warned,
+		RecordWarnings: p.warnings,
+	}
+	p.errors = nil
+	p.warnings = nil
+
+	return update
+}
+
+// report sends the progress unless it was sent recently
+func (p *importProgress) report(ctx context.Context) error {
+	if time.Since(p.reportedAt) < importProgressInterval {
+		return nil
+	}
+	p.reportedAt = time.Now()
+
+	_, err := p.client.UpdateImportJob(ctx, p.update(pb.ImportJobStatus_IMPORT_JOB_STATUS_RUNNING))
+
+	return err
+}
+
+// parseImportOptions reads mode and dry_run upload parameters from the query or the form
+func parseImportOptions(r *http.Request) (pb.ImportMode, bool, error) {
+	var mode pb.ImportMode
+	switch r.FormValue("mode") {
+	case "", "upsert":
+		mode = pb.ImportMode_IMPORT_MODE_UPSERT
+	case "sync":
+		mode = pb.ImportMode_IMPORT_MODE_SYNC
+	default:
+		return mode, false, errors.New("mode should be either upsert or sync")
+	}
+
+	var dryRun bool
+	if value := r.FormValue("dry_run"); value != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			return mode, false, errors.New("dry_run should be either true or false")
+		}
+	}
+
+	return mode, dryRun, nil
+}
+
+// parseImportFormat reads the format upload parameter, the format is detected when it is empty or auto
+func parseImportFormat(name string) (pb.ImportFormat, error) {
+	if name == "" || name == "auto" {
+		return pb.ImportFormat_IMPORT_FORMAT_AUTO, nil
+	}
+
+	for format, n := range importFormats {
+		if n == name {
+			return format, nil
+		}
+	}
+
+	return 0, errors.New("format should be one of auto, object, array, ndjson, geojson, csv or unlocode")
+}
+
+// parseImportLoader reads the loader upload parameter, insert by default
+func parseImportLoader(name string) (pb.ImportLoader, error) {
+	switch name {
+	case "", "insert":
+		return pb.ImportLoader_IMPORT_LOADER_INSERT, nil
+	case "copy":
+		return pb.ImportLoader_IMPORT_LOADER_COPY, nil
+	default:
+		return 0, errors.New("loader should be either insert or copy")
+	}
+}
+
+// parseConflicts reads the conflict_policy and field_policies upload parameters,
+// field policies are comma separated pairs of the field and its policy, like timezone:skip_existing
+func parseConflicts(policy string, fieldPolicies string) (*pb.Conflicts, error) {
+	fields := map[string]string{}
+	for _, pair := range strings.Split(fieldPolicies, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("field_policies should be pairs of the field and its policy, got %s", pair)
+		}
+		fields[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	return conflictsFromNames(policy, fields)
+}
+
+// conflictsFromNames reads the conflict policy and the policies of the fields by their names, overwrite by default
+func conflictsFromNames(policy string, fields map[string]string) (*pb.Conflicts, error) {
+	conflicts := &pb.Conflicts{}
+
+	var err error
+	if conflicts.Policy, err = parseConflictPolicy(policy); err != nil {
+		return nil, err
+	}
+
+	if len(fields) > 0 {
+		conflicts.FieldPolicies = make(map[string]pb.ConflictPolicy, len(fields))
+		for field, name := range fields {
+			if conflicts.FieldPolicies[field], err = parseConflictPolicy(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return conflicts, nil
+}
+
+func parseConflictPolicy(name string) (pb.ConflictPolicy, error) {
+	if name == "" {
+		return pb.ConflictPolicy_CONFLICT_POLICY_OVERWRITE, nil
+	}
+
+	for policy, n := range conflictPolicies {
+		if n == name {
+			return policy, nil
+		}
+	}
+
+	return 0, errors.New("conflict policy should be one of overwrite, skip_existing, fill_empty_only or fail_on_conflict")
+}
+
+func parseImportJobStatus(name string) (pb.ImportJobStatus, bool) {
+	for st, n := range importJobStatuses {
+		if n == strings.TrimSpace(name) {
+			return st, true
+		}
+	}
+
+	return 0, false
+}
+
+// decodeErrors tells which field of the record could not be decoded
+func decodeErrors(err error) []*pb.FieldError {
+	var fieldErr *csvparser.FieldError
+	if errors.As(err, &fieldErr) {
+		return []*pb.FieldError{{Field: fieldErr.Field, Reason: fieldErr.Reason, Rule: "decode"}}
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return []*pb.FieldError{{
+			Field:  typeErr.Field,
+			Reason: fmt.Sprintf("value must be %s, got %s", typeErr.Type, typeErr.Value),
+			Rule:   "decode",
+		}}
+	}
+
+	return []*pb.FieldError{{Reason: err.Error(), Rule: "decode"}}
+}
+
+// uploadFileName keeps the base name of the uploaded file short enough to be stored with the job
+func uploadFileName(name string) string {
+	name = filepath.Base(name)
+	if len(name) > maxFileNameLength {
+		name = name[:maxFileNameLength]
+	}
+
+	return name
+}
+
+// ImportJob is the import of the uploaded file run in the background
+type ImportJob struct {
+	ID             int64             `json:"id"`
+	Status         string            `json:"status"`
+	Mode           string            `json:"mode"`
+	DryRun         bool              `json:"dry_run"`
+	FileName       string            `json:"file_name"`
+	Format         string            `json:"format"`
+	SlugProperty   string            `json:"slug_property,omitempty"`
+	Loader         string            `json:"loader"`
+	ConflictPolicy string            `json:"conflict_policy"`
+	FieldPolicies  map[string]string `json:"field_policies,omitempty"`
+	Actor          string            `json:"actor"`
+	Processed      int64             `json:"processed"`
+	Failed         int64             `json:"failed"`
+	Errors         []string          `json:"errors"`
+	RecordErrors   []RecordError     `json:"record_errors"`
+	Warned         int64             `json:"warned"`
+	RecordWarnings []RecordError     `json:"record_warnings"`
+	Summary        *ImportSummary    `json:"summary,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+	FinishedAt     *time.Time        `json:"finished_at,omitempty"`
+}
+
+// RecordError is a record of the uploaded file which failed, or was stored with warnings
+type RecordError struct {
+	Key    string       `json:"key"`
+	Offset int64        `json:"offset"`
+	Errors []FieldError `json:"errors"`
+	File   string       `json:"file,omitempty"`
+}
+
+// FieldError tells why the field of the record is invalid and which rule it breaks, like required or decode
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+	Rule   string `json:"rule,omitempty"`
+}
+
+// ImportSummary counts the ports the import has changed, or would change on dry run
+type ImportSummary struct {
+	Created   int64 `json:"created"`
+	Updated   int64 `json:"updated"`
+	Unchanged int64 `json:"unchanged"`
+	Removed   int64 `json:"removed"`
+}
+
+func fromPbImportJob(proto *pb.ImportJob) ImportJob {
+	job := ImportJob{
+		ID:           proto.Id,
+		Status:       importJobStatuses[proto.Status],
+		Mode:         "upsert",
+		Loader:       "insert",
+		DryRun:       proto.DryRun,
+		FileName:     proto.FileName,
+		Format:       importFormats[proto.Format],
+		SlugProperty: proto.SlugProperty,
+		Actor:        proto.Actor,
+		Processed:    proto.Processed,
+		Failed:       proto.Failed,
+		Errors:       proto.Errors,
+		Warned:       proto.Warned,
+	}
+
+	if proto.Mode == pb.ImportMode_IMPORT_MODE_SYNC {
+		job.Mode = "sync"
+	}
+
+	if proto.Loader == pb.ImportLoader_IMPORT_LOADER_COPY {
+		job.Loader = "copy"
+	}
+
+	job.ConflictPolicy = conflictPolicies[proto.Conflicts.GetPolicy()]
+	for field, policy := range proto.Conflicts.GetFieldPolicies() {
+		if job.FieldPolicies == nil {
+			job.FieldPolicies = map[string]string{}
+		}
+		job.FieldPolicies[field] = conflictPolicies[policy]
+	}
+
+	if job.Format == "" {
+		job.Format = "auto"
+	}
+
+	if job.Errors == nil {
+		job.Errors = []string{}
+	}
+
+	job.RecordErrors = fromPbRecordErrors(proto.RecordErrors)
+	job.RecordWarnings = fromPbRecordErrors(proto.RecordWarnings)
+
+	if proto.Summary != nil {
+		job.Summary = &ImportSummary{
+			Created:   proto.Summary.Created,
+			Updated:   proto.Summary.Updated,
+			Unchanged: proto.Summary.Unchanged,
+			Removed:   proto.Summary.Removed,
+		}
+	}
+
+	if createdAt, err := ptypes.Timestamp(proto.CreatedAt); err == nil {
+		job.CreatedAt = createdAt
+	}
+
+	if updatedAt, err := ptypes.Timestamp(proto.UpdatedAt); err == nil {
+		job.UpdatedAt = updatedAt
+	}
+
+	if proto.FinishedAt != nil {
+		if finishedAt, err := ptypes.Timestamp(proto.FinishedAt); err == nil {
+			job.FinishedAt = &finishedAt
+		}
+	}
+
+	return job
+}
+
+func fromPbRecordErrors(protos []*pb.RecordError) []RecordError {
+	records := make([]RecordError, 0, len(protos))
+	for _, e := range protos {
+		record := RecordError{Key: e.Key, Offset: e.Offset, File: e.File, Errors: make([]FieldError, 0, len(e.Errors))}
+		for _, fe := range e.Errors {
+			record.Errors = append(record.Errors, FieldError{Field: fe.Field, Reason: fe.Reason, Rule: fe.Rule})
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
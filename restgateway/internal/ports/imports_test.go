@@ -0,0 +1,166 @@
+package ports
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// uploadForm is the multipart form of the fields and the file, gzip compressed as a whole when gz is set
+func uploadForm(t *testing.T, fields map[string]string, file string, gz bool) *http.Request {
+	r := require.New(t)
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for name, value := range fields {
+		r.NoError(form.WriteField(name, value))
+	}
+	if file != "" {
+		part, err := form.CreateFormFile("file", "ports.json")
+		r.NoError(err)
+		_, err = part.Write([]byte(file))
+		r.NoError(err)
+	}
+	r.NoError(form.Close())
+
+	if !gz {
+		return newRequest(http.MethodPost, "/upload-ports?format=object", form.FormDataContentType(), body.String())
+	}
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	_, err := zw.Write(body.Bytes())
+	r.NoError(err)
+	r.NoError(zw.Close())
+
+	req := newRequest(http.MethodPost, "/upload-ports?format=object", form.FormDataContentType(), compressed.String())
+	req.Header.Set("Content-Encoding", "gzip")
+
+	return req
+}
+
+func TestPortServer_UploadPortsState(t *testing.T) {
+	const file = `{"NLRTM": {"name": "Rotterdam"}}`
+
+	for _, gz := range []bool{false, true} {
+		name := "plain"
+		if gz {
+			name = "gzip encoded"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			var created *pb.CreateImportJobRequest
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			srv := newTestServer(t, &portsClientMock{
+				createImportJob: func(req *pb.CreateImportJobRequest) (*pb.ImportJobResponse, error) {
+					created = req
+					return &pb.ImportJobResponse{Data: &pb.ImportJob{Id: 7, Mode: req.Mode, FileName: req.FileName}}, nil
+				},
+				// the gateway is shutting down, so the job is left for the next run
+				updateImportJob: func(req *pb.UpdateImportJobRequest) (*pb.ImportJobResponse, error) {
+					return nil, context.Canceled
+				},
+			})
+			srv.jobsCtx = ctx
+
+			rec := route(srv, uploadForm(t, map[string]string{"mode": "sync", "slug_property": "code"}, file, gz))
+			r.Equal(http.StatusAccepted, rec.Code, rec.Body.String())
+			r.Equal("/imports/7", rec.Header().Get("Location"))
+			r.True(proto.Equal(&pb.CreateImportJobRequest{
+				Mode:         pb.ImportMode_IMPORT_MODE_SYNC,
+				FileName:     "ports.json",
+				Format:       pb.ImportFormat_IMPORT_FORMAT_OBJECT,
+				SlugProperty: "code",
+				Conflicts:    &pb.Conflicts{},
+			}, created), created.String())
+			srv.WaitImportJobs()
+
+			data, err := ioutil.ReadFile(srv.spoolPath(7))
+			r.NoError(err)
+			r.Equal(file, string(data))
+		})
+	}
+}
+
+func TestPortServer_UploadPortsStateInvalid(t *testing.T) {
+	cases := []struct {
+		name string
+		req  func(t *testing.T) *http.Request
+		code int
+	}{
+		{
+			name: "not multipart",
+			req: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/upload-ports", "application/json", `{"NLRTM": {}}`)
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "no file",
+			req: func(t *testing.T) *http.Request {
+				return uploadForm(t, map[string]string{"mode": "sync"}, "", false)
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "wrong mode",
+			req: func(t *testing.T) *http.Request {
+				return uploadForm(t, map[string]string{"mode": "replace"}, `{"NLRTM": {}}`, false)
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "invalid gzip body",
+			req: func(t *testing.T) *http.Request {
+				req := uploadForm(t, nil, `{"NLRTM": {}}`, false)
+				req.Header.Set("Content-Encoding", "gzip")
+				return req
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "file too large",
+			req: func(t *testing.T) *http.Request {
+				return uploadForm(t, nil, strings.Repeat(" ", 2<<20), false)
+			},
+			code: http.StatusRequestEntityTooLarge,
+		},
+		{
+			// a few kilobytes of gzip body decompress to megabytes of the file
+			name: "decompressed file too large",
+			req: func(t *testing.T) *http.Request {
+				return uploadForm(t, nil, strings.Repeat(" ", 2<<20), true)
+			},
+			code: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			srv := newTestServer(t, &portsClientMock{})
+
+			rec := route(srv, test.req(t))
+			r.Equal(test.code, rec.Code, rec.Body.String())
+			r.Equal("application/problem+json", rec.Header().Get("Content-Type"))
+
+			// nothing is left spooled in the imports dir but the uploads dir
+			files, err := ioutil.ReadDir(srv.importsDir)
+			r.NoError(err)
+			r.Len(files, 1)
+			r.Equal("uploads", files[0].Name())
+		})
+	}
+}
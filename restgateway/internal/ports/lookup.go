@@ -0,0 +1,162 @@
+package ports
+
+import (
+	"encoding/json"
+	"fmt"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PortsLookup are the keys of the ports to fetch at once, Keys keep their order,
+// the ids, slugs and unlocks are the shorthand for the keys of a single kind and follow them
+type PortsLookup struct {
+	Keys    []PortKey `json:"keys,omitempty"`
+	IDs     []int64   `json:"ids,omitempty"`
+	Slugs   []string  `json:"slugs,omitempty"`
+	Unlocks []string  `json:"unlocks,omitempty"`
+}
+
+// PortKey is the id, slug or unlock of the port, the key with none of them matches no port
+type PortKey struct {
+	ID     *int64  `json:"id,omitempty"`
+	Slug   *string `json:"slug,omitempty"`
+	Unlock *string `json:"unlock,omitempty"`
+}
+
+// PortsLookupResult are the found ports in the order of the lookup keys, with the keys no port was found by,
+// in the order of the lookup in Keys and by their kind in the rest of the fields
+type PortsLookupResult struct {
+	Data     []Port      `json:"data"`
+	NotFound PortsLookup `json:"not_found"`
+}
+
+// LookupPorts fetches the ports by the ids, slugs and unlocks of the json body at once
+func (s *PortServer) LookupPorts(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, 1048576) // 1MB limit just in case...
+	var lookup PortsLookup
+	if err := json.NewDecoder(r.Body).Decode(&lookup); err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	for i, key := range lookup.Keys {
+		if (key.ID != nil && (key.Slug != nil || key.Unlock != nil)) || (key.Slug != nil && key.Unlock != nil) {
+			respondBadRequest(fmt.Sprintf("keys[%d] should have only one of id, slug or unlock", i), w)
+			return
+		}
+	}
+
+	s.lookupPorts(lookup, w, r)
+}
+
+// isLookupQuery tells the ports list query is the lookup of the ports by their ids, slugs or unlocks
+func isLookupQuery(query url.Values) bool {
+	return query.Get("ids") != "" || query.Get("slugs") != "" || query.Get("unlocks") != ""
+}
+
+// lookupFromQuery reads comma separated ids, slugs and unlocks query parameters, like slugs=NLRTM,BEANR
+func lookupFromQuery(query url.Values) (PortsLookup, error) {
+	lookup := PortsLookup{
+		Slugs:   queryList(query, "slugs"),
+		Unlocks: queryList(query, "unlocks"),
+	}
+
+	for _, value := range queryList(query, "ids") {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return PortsLookup{}, fmt.Errorf("wrong ids provided: %s", value)
+		}
+		lookup.IDs = append(lookup.IDs, id)
+	}
+
+	return lookup, nil
+}
+
+// queryList reads the values of the query parameter given either separated by commas or as repeated parameters
+func queryList(query url.Values, name string) []string {
+	var values []string
+	for _, param := range query[name] {
+		for _, value := range strings.Split(param, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values
+}
+
+func (s *PortServer) lookupPorts(lookup PortsLookup, w http.ResponseWriter, r *http.Request) {
+	req := &pb.BatchGetPortsRequest{}
+	for _, key := range lookup.Keys {
+		req.Keys = append(req.Keys, toPbPortKey(key))
+	}
+	for _, id := range lookup.IDs {
+		req.Keys = append(req.Keys, &pb.PortKey{Key: &pb.PortKey_Id{Id: id}})
+	}
+	for _, slug := range lookup.Slugs {
+		req.Keys = append(req.Keys, &pb.PortKey{Key: &pb.PortKey_Slug{Slug: slug}})
+	}
+	for _, unlock := range lookup.Unlocks {
+		req.Keys = append(req.Keys, &pb.PortKey{Key: &pb.PortKey_Unlock{Unlock: unlock}})
+	}
+
+	res, err := s.portsClient.BatchGetPorts(r.Context(), req)
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	result := PortsLookupResult{Data: make([]Port, 0, len(res.Data))}
+	for _, v := range res.Data {
+		result.Data = append(result.Data, fromPbPort(v))
+	}
+
+	for _, key := range res.NotFound {
+		result.NotFound.Keys = append(result.NotFound.Keys, fromPbPortKey(key))
+		switch k := key.Key.(type) {
+		case *pb.PortKey_Id:
+			result.NotFound.IDs = append(result.NotFound.IDs, k.Id)
+		case *pb.PortKey_Slug:
+			result.NotFound.Slugs = append(result.NotFound.Slugs, k.Slug)
+		case *pb.PortKey_Unlock:
+			result.NotFound.Unlocks = append(result.NotFound.Unlocks, k.Unlock)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+		respondError(err.Error(), w)
+	}
+}
+
+func toPbPortKey(key PortKey) *pb.PortKey {
+	switch {
+	case key.ID != nil:
+		return &pb.PortKey{Key: &pb.PortKey_Id{Id: *key.ID}}
+	case key.Slug != nil:
+		return &pb.PortKey{Key: &pb.PortKey_Slug{Slug: *key.Slug}}
+	case key.Unlock != nil:
+		return &pb.PortKey{Key: &pb.PortKey_Unlock{Unlock: *key.Unlock}}
+	default:
+		return &pb.PortKey{}
+	}
+}
+
+func fromPbPortKey(proto *pb.PortKey) PortKey {
+	var key PortKey
+	switch k := proto.Key.(type) {
+	case *pb.PortKey_Id:
+		key.ID = &k.Id
+	case *pb.PortKey_Slug:
+		key.Slug = &k.Slug
+	case *pb.PortKey_Unlock:
+		key.Unlock = &k.Unlock
+	}
+
+	return key
+}
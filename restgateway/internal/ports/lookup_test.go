@@ -0,0 +1,175 @@
+package ports
+
+import (
+	"encoding/json"
+	"fmt"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"testing"
+)
+
+// keyName names the lookup key like id:1, slug:NLRTM or unlock:NLEUR
+func keyName(key *pb.PortKey) string {
+	switch k := key.Key.(type) {
+	case *pb.PortKey_Id:
+		return fmt.Sprintf("id:%d", k.Id)
+	case *pb.PortKey_Slug:
+		return "slug:" + k.Slug
+	case *pb.PortKey_Unlock:
+		return "unlock:" + k.Unlock
+	default:
+		return ""
+	}
+}
+
+// lookupClient finds Rotterdam by its id, slug and unlocks, the other keys are not found,
+// the names of the keys of the last lookup are kept in keys
+func lookupClient(keys *[]string) *portsClientMock {
+	found := map[string]bool{"id:1": true, "slug:NLRTM": true, "unlock:NLRTM": true, "unlock:NLEUR": true}
+
+	return &portsClientMock{
+		batchGetPorts: func(req *pb.BatchGetPortsRequest) (*pb.BatchGetPortsResponse, error) {
+			*keys = nil
+			res := &pb.BatchGetPortsResponse{}
+			for _, key := range req.Keys {
+				*keys = append(*keys, keyName(key))
+				switch {
+				case !found[keyName(key)]:
+					res.NotFound = append(res.NotFound, key)
+				case len(res.Data) == 0:
+					res.Data = append(res.Data, rotterdam("Rotterdam", 1))
+				}
+			}
+
+			return res, nil
+		},
+	}
+}
+
+func idKey(id int64) PortKey {
+	return PortKey{ID: &id}
+}
+
+func slugKey(slug string) PortKey {
+	return PortKey{Slug: &slug}
+}
+
+func unlockKey(unlock string) PortKey {
+	return PortKey{Unlock: &unlock}
+}
+
+func TestPortServer_LookupPorts(t *testing.T) {
+	cases := []struct {
+		name     string
+		req      *http.Request
+		code     int
+		keys     []string
+		slugs    []string
+		notFound PortsLookup
+	}{
+		{
+			name:  "query lists",
+			req:   newRequest(http.MethodGet, "/ports?ids=1,%207&slugs=NLRTM,BEANR&unlocks=NLEUR", "", ""),
+			code:  http.StatusOK,
+			keys:  []string{"id:1", "id:7", "slug:NLRTM", "slug:BEANR", "unlock:NLEUR"},
+			slugs: []string{"NLRTM"},
+			notFound: PortsLookup{
+				Keys:  []PortKey{idKey(7), slugKey("BEANR")},
+				IDs:   []int64{7},
+				Slugs: []string{"BEANR"},
+			},
+		},
+		{
+			name:     "repeated query parameters",
+			req:      newRequest(http.MethodGet, "/ports?unlocks=USNYC&unlocks=NLRTM,&unlocks=DEHAM", "", ""),
+			code:     http.StatusOK,
+			keys:     []string{"unlock:USNYC", "unlock:NLRTM", "unlock:DEHAM"},
+			slugs:    []string{"NLRTM"},
+			notFound: PortsLookup{Keys: []PortKey{unlockKey("USNYC"), unlockKey("DEHAM")}, Unlocks: []string{"USNYC", "DEHAM"}},
+		},
+		{
+			name:     "nothing found",
+			req:      newRequest(http.MethodGet, "/ports?slugs=BEANR", "", ""),
+			code:     http.StatusOK,
+			keys:     []string{"slug:BEANR"},
+			slugs:    []string{},
+			notFound: PortsLookup{Keys: []PortKey{slugKey("BEANR")}, Slugs: []string{"BEANR"}},
+		},
+		{
+			name: "wrong ids",
+			req:  newRequest(http.MethodGet, "/ports?ids=1,NLRTM", "", ""),
+			code: http.StatusBadRequest,
+		},
+		{
+			name:     "post",
+			req:      newRequest(http.MethodPost, "/ports/lookup", "application/json", `{"ids": [7], "slugs": ["NLRTM"], "unlocks": ["NLEUR", "USNYC"]}`),
+			code:     http.StatusOK,
+			keys:     []string{"id:7", "slug:NLRTM", "unlock:NLEUR", "unlock:USNYC"},
+			slugs:    []string{"NLRTM"},
+			notFound: PortsLookup{Keys: []PortKey{idKey(7), unlockKey("USNYC")}, IDs: []int64{7}, Unlocks: []string{"USNYC"}},
+		},
+		{
+			name:  "post ordered keys",
+			req:   newRequest(http.MethodPost, "/ports/lookup", "application/json", `{"keys": [{"unlock": "USNYC"}, {"slug": "NLRTM"}, {}, {"id": 7}, {"unlock": "NLEUR"}], "slugs": ["BEANR"]}`),
+			code:  http.StatusOK,
+			keys:  []string{"unlock:USNYC", "slug:NLRTM", "", "id:7", "unlock:NLEUR", "slug:BEANR"},
+			slugs: []string{"NLRTM"},
+			notFound: PortsLookup{
+				Keys:    []PortKey{unlockKey("USNYC"), {}, idKey(7), slugKey("BEANR")},
+				IDs:     []int64{7},
+				Slugs:   []string{"BEANR"},
+				Unlocks: []string{"USNYC"},
+			},
+		},
+		{
+			name: "post key of two kinds",
+			req:  newRequest(http.MethodPost, "/ports/lookup", "application/json", `{"keys": [{"slug": "NLRTM"}, {"id": 1, "slug": "NLRTM"}]}`),
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "post invalid body",
+			req:  newRequest(http.MethodPost, "/ports/lookup", "application/json", `{"ids": ["NLRTM"]}`),
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			var keys []string
+			rec := serve(t, lookupClient(&keys), test.req)
+			r.Equal(test.code, rec.Code, rec.Body.String())
+			r.Equal(test.keys, keys)
+			if test.code != http.StatusOK {
+				return
+			}
+
+			var result PortsLookupResult
+			r.NoError(json.Unmarshal(rec.Body.Bytes(), &result))
+
+			slugs := []string{}
+			for _, port := range result.Data {
+				slugs = append(slugs, port.Slug)
+			}
+			r.Equal(test.slugs, slugs)
+			r.Equal(test.notFound, result.NotFound)
+		})
+	}
+}
+
+func TestPortServer_LookupPortsInvalid(t *testing.T) {
+	r := require.New(t)
+
+	rec := serve(t, &portsClientMock{
+		batchGetPorts: func(req *pb.BatchGetPortsRequest) (*pb.BatchGetPortsResponse, error) {
+			return nil, status.Error(codes.InvalidArgument, "invalid lookup: keys[0].slug: value does not match regex pattern")
+		},
+	}, newRequest(http.MethodGet, "/ports?slugs=nlrtm", "", ""))
+
+	r.Equal(http.StatusBadRequest, rec.Code)
+	r.Equal("application/problem+json", rec.Header().Get("Content-Type"))
+}
@@ -0,0 +1,540 @@
+package ports
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// mergePatchContentType is the content type of JSON Merge Patch (RFC 7396), the fields of the patch replace
+	// the fields of the port and null clears them
+	mergePatchContentType = "application/merge-patch+json"
+	// jsonPatchContentType is the content type of JSON Patch (RFC 6902), the list of operations on the port
+	jsonPatchContentType = "application/json-patch+json"
+	// patchAttempts is the number of times the patch is applied when the port changes in the meantime
+	// and the request has no If-Match header
+	patchAttempts = 3
+)
+
+// readOnlyFields are the fields of the port the patch can't change
+var readOnlyFields = []string{"id", "slug", "version"}
+
+// errPatchTestFailed is returned when the test operation of JSON Patch finds another value
+var errPatchTestFailed = errors.New("test failed")
+
+// PatchPort changes the fields of existing Port record by id or slug, the body is either JSON Merge Patch
+// (application/merge-patch+json, application/json as well) or JSON Patch (application/json-patch+json),
+// the fields set to null or removed are cleared
+// If-Match header with the port ETag makes the patch fail with 412 when the port was changed in the meantime,
+// without it the patch is applied again to the changed port
+func (s *PortServer) PatchPort(w http.ResponseWriter, r *http.Request) {
+	id, slug, err := parseIDOrSlug(r)
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || (contentType != mergePatchContentType && contentType != jsonPatchContentType && contentType != "application/json") {
+		w.Header().Set("Accept-Patch", mergePatchContentType+", "+jsonPatchContentType)
+		respondStatus(http.StatusUnsupportedMediaType, fmt.Sprintf("content type should be either %s or %s", mergePatchContentType, jsonPatchContentType), w)
+		return
+	}
+
+	expected, err := parseIfMatch(r.Header.Get("If-Match"))
+	if err != nil {
+		respondPreconditionFailed(err.Error(), w)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, 1048576) // 1MB limit just in case...
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	apply := applyMergePatch
+	if contentType == jsonPatchContentType {
+		apply = applyJSONPatch
+	}
+
+	for attempt := 1; ; attempt++ {
+		current, err := s.portsClient.FetchPort(r.Context(), &pb.PortRequest{Id: id, Slug: slug})
+		if err != nil {
+			respondGRPCError(err, w)
+			return
+		}
+
+		req, err := patchRequest(fromPbPort(current.Data), body, apply)
+		if errors.Is(err, errPatchTestFailed) {
+			respondStatus(http.StatusConflict, err.Error(), w)
+			return
+		}
+		if err != nil {
+			respondBadRequest(err.Error(), w)
+			return
+		}
+		req.Id, req.Slug = id, slug
+		req.ExpectedVersion = expected
+		if req.ExpectedVersion == nil {
+			req.ExpectedVersion = &wrappers.Int64Value{Value: current.Data.Version}
+		}
+
+		res, err := s.portsClient.UpdatePort(r.Context(), req)
+		if status.Code(err) == codes.FailedPrecondition && expected == nil && attempt < patchAttempts {
+			continue
+		}
+		if err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				respondPreconditionFailed(status.Convert(err).Message(), w)
+				return
+			}
+			respondGRPCError(err, w)
+			return
+		}
+
+		w.Header().Set("ETag", versionETag(res.Data.Version))
+		setWarnings(res.Warnings, w)
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(fromPbPort(res.Data)); err != nil {
+			fmt.Printf("error encoding response payload. err: %v", err)
+			respondError(err.Error(), w)
+		}
+
+		return
+	}
+}
+
+// patchRequest applies the patch to the port and builds the update of the fields the patch has changed
+func patchRequest(port Port, patch []byte, apply func(doc interface{}, patch []byte) (interface{}, error)) (*pb.UpdatePortRequest, error) {
+	doc, err := portDocument(port)
+	if err != nil {
+		return nil, err
+	}
+
+	// the patch changes the document in place, the original one tells which fields have changed
+	patched, err := apply(copyValue(doc), patch)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := patched.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("patched port should be an object")
+	}
+
+	// the fields of the mask are the ones the patch has changed
+	var paths []string
+	for name, value := range fields {
+		if !reflect.DeepEqual(value, doc[name]) {
+			paths = append(paths, name)
+		}
+	}
+	for name := range doc {
+		if _, ok := fields[name]; !ok {
+			paths = append(paths, name)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		for _, field := range readOnlyFields {
+			if path == field {
+				return nil, fmt.Errorf("field %s is read only", path)
+			}
+		}
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var patchedPort Port
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&patchedPort); err != nil {
+		return nil, err
+	}
+
+	if n := len(patchedPort.Coordinates); n != 0 && n != 2 {
+		return nil, errors.New("coordinates should be [longitude, latitude]")
+	}
+
+	return &pb.UpdatePortRequest{
+		Data:       toPbPortUpdatable(patchedPort),
+		UpdateMask: &field_mask.FieldMask{Paths: paths},
+	}, nil
+}
+
+// portDocument turns the port into the json document the patch is applied to, the empty lists are [] instead of null
+// so the values can be added to them
+func portDocument(port Port) (map[string]interface{}, error) {
+	for _, list := range []*[]string{&port.Alias, &port.Regions, &port.Unlocks} {
+		if *list == nil {
+			*list = []string{}
+		}
+	}
+
+	data, err := json.Marshal(port)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// applyMergePatch applies JSON Merge Patch (RFC 7396) to the document
+func applyMergePatch(doc interface{}, patch []byte) (interface{}, error) {
+	var p interface{}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %s", err)
+	}
+
+	if _, ok := p.(map[string]interface{}); !ok {
+		return nil, errors.New("merge patch should be an object")
+	}
+
+	return mergePatch(doc, p), nil
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = map[string]interface{}{}
+	}
+
+	for name, value := range p {
+		if value == nil {
+			delete(t, name)
+			continue
+		}
+		t[name] = mergePatch(t[name], value)
+	}
+
+	return t
+}
+
+// patchOperation is the operation of JSON Patch, Value is raw so the missing value is told apart from null
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// applyJSONPatch applies the operations of JSON Patch (RFC 6902) to the document one by one,
+// the patch fails as a whole when any of them fails
+func applyJSONPatch(doc interface{}, patch []byte) (interface{}, error) {
+	var ops []patchOperation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("invalid json patch: %s", err)
+	}
+
+	for i, op := range ops {
+		var err error
+		if doc, err = op.apply(doc); err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	return doc, nil
+}
+
+func (op patchOperation) apply(doc interface{}) (interface{}, error) {
+	if op.Path == nil {
+		return nil, errors.New("path is required")
+	}
+
+	path, err := parsePointer(*op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return nil, fmt.Errorf("%s needs the value", op.Op)
+		}
+
+		var value interface{}
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return nil, err
+		}
+
+		switch op.Op {
+		case "add":
+			return addValue(doc, path, value)
+		case "replace":
+			return replaceValue(doc, path, value)
+		}
+
+		current, err := getValue(doc, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !reflect.DeepEqual(current, value) {
+			return nil, fmt.Errorf("%w, %s is not %s", errPatchTestFailed, *op.Path, op.Value)
+		}
+
+		return doc, nil
+	case "remove":
+		doc, _, err := removeValue(doc, path)
+		return doc, err
+	case "move", "copy":
+		if op.From == nil {
+			return nil, fmt.Errorf("%s needs from", op.Op)
+		}
+
+		from, err := parsePointer(*op.From)
+		if err != nil {
+			return nil, err
+		}
+
+		if op.Op == "copy" {
+			value, err := getValue(doc, from)
+			if err != nil {
+				return nil, err
+			}
+
+			return addValue(doc, path, copyValue(value))
+		}
+
+		if len(from) < len(path) && reflect.DeepEqual(from, path[:len(from)]) {
+			return nil, errors.New("can't move the value into itself")
+		}
+
+		doc, value, err := removeValue(doc, from)
+		if err != nil {
+			return nil, err
+		}
+
+		return addValue(doc, path, value)
+	default:
+		return nil, fmt.Errorf("unknown operation %q, should be one of add, remove, replace, move, copy or test", op.Op)
+	}
+}
+
+// parsePointer splits JSON Pointer (RFC 6901) into its reference tokens, the empty pointer is the whole document
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("path %q should start with /", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+
+	return tokens, nil
+}
+
+func getValue(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch c := doc.(type) {
+		case map[string]interface{}:
+			value, ok := c[token]
+			if !ok {
+				return nil, fmt.Errorf("field %s does not exist", token)
+			}
+			doc = value
+		case []interface{}:
+			i, err := arrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			doc = c[i]
+		default:
+			return nil, fmt.Errorf("can't find %s in the value", token)
+		}
+	}
+
+	return doc, nil
+}
+
+func addValue(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return patchParent(doc, path, func(parent interface{}, token string) (interface{}, error) {
+		switch c := parent.(type) {
+		case map[string]interface{}:
+			c[token] = value
+			return c, nil
+		case []interface{}:
+			if token == "-" {
+				return append(c, value), nil
+			}
+
+			i, err := arrayIndex(token, len(c))
+			if err != nil {
+				return nil, err
+			}
+
+			return append(c[:i], append([]interface{}{value}, c[i:]...)...), nil
+		default:
+			return nil, fmt.Errorf("can't add %s to the value", token)
+		}
+	})
+}
+
+func replaceValue(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return patchParent(doc, path, func(parent interface{}, token string) (interface{}, error) {
+		switch c := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := c[token]; !ok {
+				return nil, fmt.Errorf("field %s does not exist", token)
+			}
+			c[token] = value
+			return c, nil
+		case []interface{}:
+			i, err := arrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			c[i] = value
+			return c, nil
+		default:
+			return nil, fmt.Errorf("can't replace %s in the value", token)
+		}
+	})
+}
+
+// removeValue removes the value from the document and returns it
+func removeValue(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, errors.New("can't remove the whole port")
+	}
+
+	var removed interface{}
+	doc, err := patchParent(doc, path, func(parent interface{}, token string) (interface{}, error) {
+		switch c := parent.(type) {
+		case map[string]interface{}:
+			value, ok := c[token]
+			if !ok {
+				return nil, fmt.Errorf("field %s does not exist", token)
+			}
+			removed = value
+			delete(c, token)
+			return c, nil
+		case []interface{}:
+			i, err := arrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			removed = c[i]
+			return append(c[:i], c[i+1:]...), nil
+		default:
+			return nil, fmt.Errorf("can't remove %s from the value", token)
+		}
+	})
+
+	return doc, removed, err
+}
+
+// patchParent changes the value holding the last token of the path with fn and puts the changed value back in place,
+// the arrays are put back since adding to them makes new ones
+func patchParent(doc interface{}, path []string, fn func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(doc, path[0])
+	}
+
+	switch c := doc.(type) {
+	case map[string]interface{}:
+		child, ok := c[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("field %s does not exist", path[0])
+		}
+
+		value, err := patchParent(child, path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		c[path[0]] = value
+
+		return c, nil
+	case []interface{}:
+		i, err := arrayIndex(path[0], len(c)-1)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := patchParent(c[i], path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		c[i] = value
+
+		return c, nil
+	default:
+		return nil, fmt.Errorf("can't find %s in the value", path[0])
+	}
+}
+
+// arrayIndex reads the array index of the path, up to max
+func arrayIndex(token string, max int) (int, error) {
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %s", token)
+	}
+
+	if i > max {
+		return 0, fmt.Errorf("array index %s is out of range", token)
+	}
+
+	return i, nil
+}
+
+// copyValue copies the value of the document so the copy is changed on its own
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for name, field := range v {
+			res[name] = copyValue(field)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			res = append(res, copyValue(item))
+		}
+		return res
+	default:
+		return v
+	}
+}
@@ -0,0 +1,503 @@
+package ports
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"testing"
+)
+
+// decodeJSON reads the json document of the test case
+func decodeJSON(t *testing.T, doc string) interface{} {
+	var value interface{}
+	require.NoError(t, json.Unmarshal([]byte(doc), &value))
+
+	return value
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	// the examples of RFC 7396 appendix A, the patch of the port has to be an object
+	cases := []struct {
+		target string
+		patch  string
+		result string
+		err    bool
+	}{
+		{target: `{"a":"b"}`, patch: `{"a":"c"}`, result: `{"a":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"b":"c"}`, result: `{"a":"b","b":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"a":null}`, result: `{}`},
+		{target: `{"a":"b","b":"c"}`, patch: `{"a":null}`, result: `{"b":"c"}`},
+		{target: `{"a":["b"]}`, patch: `{"a":"c"}`, result: `{"a":"c"}`},
+		{target: `{"a":"c"}`, patch: `{"a":["b"]}`, result: `{"a":["b"]}`},
+		{target: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, result: `{"a":{"b":"d"}}`},
+		{target: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, result: `{"a":[1]}`},
+		{target: `{"e":null}`, patch: `{"a":1}`, result: `{"e":null,"a":1}`},
+		{target: `[1,2]`, patch: `{"a":"b","c":null}`, result: `{"a":"b"}`},
+		{target: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, result: `{"a":{"bb":{}}}`},
+		{target: `{"a":"b"}`, patch: `["c"]`, err: true},
+		{target: `{"a":"foo"}`, patch: `null`, err: true},
+		{target: `{"a":"foo"}`, patch: `"bar"`, err: true},
+		{target: `{"a":"foo"}`, patch: `{"a":`, err: true},
+	}
+
+	for _, test := range cases {
+		t.Run(test.target+" "+test.patch, func(t *testing.T) {
+			r := require.New(t)
+
+			res, err := applyMergePatch(decodeJSON(t, test.target), []byte(test.patch))
+			if test.err {
+				r.Error(err)
+				return
+			}
+			r.NoError(err)
+			r.Equal(decodeJSON(t, test.result), res)
+		})
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	// the examples of RFC 6902 appendix A first
+	cases := []struct {
+		name   string
+		doc    string
+		patch  string
+		result string
+		err    error
+	}{
+		{
+			name:   "add object member",
+			doc:    `{"foo":"bar"}`,
+			patch:  `[{"op":"add","path":"/baz","value":"qux"}]`,
+			result: `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			name:   "add array element",
+			doc:    `{"foo":["bar","baz"]}`,
+			patch:  `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			result: `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:   "remove object member",
+			doc:    `{"baz":"qux","foo":"bar"}`,
+			patch:  `[{"op":"remove","path":"/baz"}]`,
+			result: `{"foo":"bar"}`,
+		},
+		{
+			name:   "remove array element",
+			doc:    `{"foo":["bar","qux","baz"]}`,
+			patch:  `[{"op":"remove","path":"/foo/1"}]`,
+			result: `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:   "replace value",
+			doc:    `{"baz":"qux","foo":"bar"}`,
+			patch:  `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			result: `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:   "move value",
+			doc:    `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch:  `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			result: `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:   "move array element",
+			doc:    `{"foo":["all","grass","cows","eat"]}`,
+			patch:  `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			result: `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name:   "test value success",
+			doc:    `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch:  `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			result: `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			name:  "test value error",
+			doc:   `{"baz":"qux"}`,
+			patch: `[{"op":"test","path":"/baz","value":"bar"}]`,
+			err:   errPatchTestFailed,
+		},
+		{
+			name:   "add nested member object",
+			doc:    `{"foo":"bar"}`,
+			patch:  `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`,
+			result: `{"foo":"bar","child":{"grandchild":{}}}`,
+		},
+		{
+			name:   "ignore unrecognized elements",
+			doc:    `{"foo":"bar"}`,
+			patch:  `[{"op":"add","path":"/baz","value":"qux","xyz":123}]`,
+			result: `{"foo":"bar","baz":"qux"}`,
+		},
+		{
+			name:  "add to nonexistent target",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			err:   errors.New("operation 0: field baz does not exist"),
+		},
+		{
+			name:   "~ escape ordering",
+			doc:    `{"/":9,"~1":10}`,
+			patch:  `[{"op":"test","path":"/~01","value":10}]`,
+			result: `{"/":9,"~1":10}`,
+		},
+		{
+			name:  "comparing strings and numbers",
+			doc:   `{"/":9,"~1":10}`,
+			patch: `[{"op":"test","path":"/~01","value":"10"}]`,
+			err:   errPatchTestFailed,
+		},
+		{
+			name:   "add array value",
+			doc:    `{"foo":["bar"]}`,
+			patch:  `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			result: `{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			name:   "escaped slash",
+			doc:    `{"a/b":1}`,
+			patch:  `[{"op":"replace","path":"/a~1b","value":2}]`,
+			result: `{"a/b":2}`,
+		},
+		{
+			name:   "copy value",
+			doc:    `{"foo":{"bar":["a"]}}`,
+			patch:  `[{"op":"copy","from":"/foo/bar","path":"/baz"},{"op":"add","path":"/baz/-","value":"b"}]`,
+			result: `{"foo":{"bar":["a"]},"baz":["a","b"]}`,
+		},
+		{
+			name:   "null value",
+			doc:    `{"foo":"bar"}`,
+			patch:  `[{"op":"replace","path":"/foo","value":null}]`,
+			result: `{"foo":null}`,
+		},
+		{
+			name:  "missing value",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"replace","path":"/foo"}]`,
+			err:   errors.New("operation 0: replace needs the value"),
+		},
+		{
+			name:  "missing path",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"remove"}]`,
+			err:   errors.New("operation 0: path is required"),
+		},
+		{
+			name:  "path without leading slash",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"remove","path":"foo"}]`,
+			err:   errors.New(`operation 0: path "foo" should start with /`),
+		},
+		{
+			name:  "index with leading zero",
+			doc:   `{"foo":["a","b"]}`,
+			patch: `[{"op":"remove","path":"/foo/01"}]`,
+			err:   errors.New("operation 0: invalid array index 01"),
+		},
+		{
+			name:  "index out of range",
+			doc:   `{"foo":["a","b"]}`,
+			patch: `[{"op":"add","path":"/foo/3","value":"c"}]`,
+			err:   errors.New("operation 0: array index 3 is out of range"),
+		},
+		{
+			name:  "remove past the end",
+			doc:   `{"foo":["a","b"]}`,
+			patch: `[{"op":"remove","path":"/foo/-"}]`,
+			err:   errors.New("operation 0: invalid array index -"),
+		},
+		{
+			name:  "move into itself",
+			doc:   `{"foo":{"bar":{}}}`,
+			patch: `[{"op":"move","from":"/foo","path":"/foo/bar/baz"}]`,
+			err:   errors.New("operation 0: can't move the value into itself"),
+		},
+		{
+			name:  "remove whole document",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"remove","path":""}]`,
+			err:   errors.New("operation 0: can't remove the whole port"),
+		},
+		{
+			name:  "unknown operation",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"merge","path":"/foo","value":"baz"}]`,
+			err:   errors.New(`operation 0: unknown operation "merge", should be one of add, remove, replace, move, copy or test`),
+		},
+		{
+			name:  "failed operation fails the patch",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz","value":1},{"op":"remove","path":"/qux"}]`,
+			err:   errors.New("operation 1: field qux does not exist"),
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			res, err := applyJSONPatch(decodeJSON(t, test.doc), []byte(test.patch))
+			switch {
+			case errors.Is(test.err, errPatchTestFailed):
+				r.True(errors.Is(err, errPatchTestFailed), "unexpected error %v", err)
+			case test.err != nil:
+				r.EqualError(err, test.err.Error())
+			default:
+				r.NoError(err)
+				r.Equal(decodeJSON(t, test.result), res)
+			}
+		})
+	}
+}
+
+func TestPatchRequest(t *testing.T) {
+	port := Port{
+		ID:          1,
+		Slug:        "NLRTM",
+		Name:        "Rotterdam",
+		Alias:       []string{"Europoort"},
+		Coordinates: []float64{4.48, 51.92},
+		Version:     2,
+	}
+
+	cases := []struct {
+		name  string
+		patch string
+		apply func(doc interface{}, patch []byte) (interface{}, error)
+		paths []string
+		err   string
+	}{
+		{
+			name:  "merge changes listed fields",
+			patch: `{"name":"Port of Rotterdam","city":"Rotterdam"}`,
+			apply: applyMergePatch,
+			paths: []string{"city", "name"},
+		},
+		{
+			name:  "merge null clears the field",
+			patch: `{"alias":null}`,
+			apply: applyMergePatch,
+			paths: []string{"alias"},
+		},
+		{
+			name:  "same value is not changed",
+			patch: `{"name":"Rotterdam"}`,
+			apply: applyMergePatch,
+		},
+		{
+			name:  "json patch adds to empty list",
+			patch: `[{"op":"add","path":"/regions/-","value":"Europe"}]`,
+			apply: applyJSONPatch,
+			paths: []string{"regions"},
+		},
+		{
+			name:  "json patch changes coordinate",
+			patch: `[{"op":"replace","path":"/coordinates/1","value":52}]`,
+			apply: applyJSONPatch,
+			paths: []string{"coordinates"},
+		},
+		{
+			name:  "read only field",
+			patch: `{"slug":"NLAMS"}`,
+			apply: applyMergePatch,
+			err:   "field slug is read only",
+		},
+		{
+			name:  "removed read only field",
+			patch: `[{"op":"remove","path":"/version"}]`,
+			apply: applyJSONPatch,
+			err:   "field version is read only",
+		},
+		{
+			name:  "unknown field",
+			patch: `{"depth":12}`,
+			apply: applyMergePatch,
+			err:   `json: unknown field "depth"`,
+		},
+		{
+			name:  "single coordinate",
+			patch: `[{"op":"remove","path":"/coordinates/0"}]`,
+			apply: applyJSONPatch,
+			err:   "coordinates should be [longitude, latitude]",
+		},
+		{
+			name:  "replaced document",
+			patch: `[{"op":"replace","path":"","value":[]}]`,
+			apply: applyJSONPatch,
+			err:   "patched port should be an object",
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			req, err := patchRequest(port, []byte(test.patch), test.apply)
+			if test.err != "" {
+				r.EqualError(err, test.err)
+				return
+			}
+			r.NoError(err)
+			r.Equal(test.paths, req.UpdateMask.Paths)
+		})
+	}
+
+	t.Run("port is not changed", func(t *testing.T) {
+		r := require.New(t)
+
+		req, err := patchRequest(port, []byte(`[{"op":"add","path":"/alias/-","value":"Maasvlakte"}]`), applyJSONPatch)
+		r.NoError(err)
+		r.Equal([]string{"Europoort", "Maasvlakte"}, req.Data.Alias)
+		r.Equal([]string{"Europoort"}, port.Alias)
+	})
+}
+
+// rotterdam is the port the ports service answers with
+func rotterdam(name string, version int64) *pb.Port {
+	return &pb.Port{
+		Id:          &wrappers.Int64Value{Value: 1},
+		Slug:        "NLRTM",
+		Name:        name,
+		Coordinates: &pb.Coordinates{Lat: 51.92, Lng: 4.48},
+		Version:     version,
+	}
+}
+
+func TestPortServer_PatchPort(t *testing.T) {
+	stale := status.Error(codes.FailedPrecondition, "port version is 3")
+
+	cases := []struct {
+		name        string
+		contentType string
+		ifMatch     string
+		body        string
+		updateErrs  []error
+		code        int
+		attempts    int
+	}{
+		{
+			name:        "merge patch",
+			contentType: mergePatchContentType,
+			body:        `{"name":"Port of Rotterdam"}`,
+			code:        http.StatusOK,
+			attempts:    1,
+		},
+		{
+			name:        "plain json is merge patch",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"name":"Port of Rotterdam"}`,
+			code:        http.StatusOK,
+			attempts:    1,
+		},
+		{
+			name:        "json patch",
+			contentType: jsonPatchContentType,
+			body:        `[{"op":"replace","path":"/name","value":"Port of Rotterdam"}]`,
+			code:        http.StatusOK,
+			attempts:    1,
+		},
+		{
+			name:        "changed meanwhile is patched again",
+			contentType: mergePatchContentType,
+			body:        `{"name":"Port of Rotterdam"}`,
+			updateErrs:  []error{stale, stale},
+			code:        http.StatusOK,
+			attempts:    3,
+		},
+		{
+			name:        "gives up after the attempts",
+			contentType: mergePatchContentType,
+			body:        `{"name":"Port of Rotterdam"}`,
+			updateErrs:  []error{stale, stale, stale},
+			code:        http.StatusPreconditionFailed,
+			attempts:    patchAttempts,
+		},
+		{
+			name:        "if-match is not patched again",
+			contentType: mergePatchContentType,
+			ifMatch:     `"2"`,
+			body:        `{"name":"Port of Rotterdam"}`,
+			updateErrs:  []error{stale},
+			code:        http.StatusPreconditionFailed,
+			attempts:    1,
+		},
+		{
+			name:        "failed test operation",
+			contentType: jsonPatchContentType,
+			body:        `[{"op":"test","path":"/name","value":"Antwerp"}]`,
+			code:        http.StatusConflict,
+		},
+		{
+			name:        "read only field",
+			contentType: mergePatchContentType,
+			body:        `{"id":7}`,
+			code:        http.StatusBadRequest,
+		},
+		{
+			name:        "malformed if-match",
+			contentType: mergePatchContentType,
+			ifMatch:     "2",
+			body:        `{"name":"Port of Rotterdam"}`,
+			code:        http.StatusPreconditionFailed,
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "text/plain",
+			body:        `name=Port of Rotterdam`,
+			code:        http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			var fetches, updates int
+			client := &portsClientMock{
+				fetchPort: func(req *pb.PortRequest) (*pb.PortResponse, error) {
+					fetches++
+					r.Equal("NLRTM", req.Slug.GetValue())
+					return &pb.PortResponse{Data: rotterdam("Rotterdam", int64(fetches+1))}, nil
+				},
+				updatePort: func(req *pb.UpdatePortRequest) (*pb.PortResponse, error) {
+					updates++
+					if test.ifMatch == "" {
+						// without If-Match the version of the fetched port is expected
+						r.Equal(int64(fetches+1), req.ExpectedVersion.GetValue())
+					}
+					if updates <= len(test.updateErrs) {
+						return nil, test.updateErrs[updates-1]
+					}
+					return &pb.PortResponse{Data: rotterdam(req.Data.Name.GetValue(), req.ExpectedVersion.GetValue()+1)}, nil
+				},
+			}
+
+			req := newRequest(http.MethodPatch, "/ports/NLRTM", test.contentType, test.body)
+			if test.ifMatch != "" {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
+			rec := serve(t, client, req)
+
+			r.Equal(test.code, rec.Code, rec.Body.String())
+			r.Equal(test.attempts, updates)
+			switch test.code {
+			case http.StatusOK:
+				var port Port
+				r.NoError(json.NewDecoder(rec.Body).Decode(&port))
+				r.Equal("Port of Rotterdam", port.Name)
+				r.Equal(versionETag(port.Version), rec.Header().Get("ETag"))
+			case http.StatusUnsupportedMediaType:
+				r.Equal(mergePatchContentType+", "+jsonPatchContentType, rec.Header().Get("Accept-Patch"))
+			default:
+				r.Equal(problemContentType, rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
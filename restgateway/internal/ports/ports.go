@@ -1,43 +1,76 @@
 package ports
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-chi/chi"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/golang/protobuf/ptypes/wrappers"
-	"github.com/kreyyser/transshipment/common/jsonparser"
 	"github.com/kreyyser/transshipment/common/router"
 	pb "github.com/kreyyser/transshipment/pb/portentries"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
-	MaxMemoryAllowed = 10 << 20 // 10MB
-	ChunkSize        = 20       // 20 ports per time
+	MaxUploadSize   = 1 << 30  // 1GB of the uploaded file by default, after its decompression
+	ChunkSize       = 20       // 20 ports per upload stream message
+	ParseBufferSize = 64 << 10 // 64KB read from the uploaded file at once
 )
 
 // PortServer is a struct to hold connections to grpc clients and to hold route handlers
 type PortServer struct {
 	portsConn   *grpc.ClientConn
 	portsClient pb.PortsServiceClient
+
+	// importsDir keeps the uploaded files until their import jobs finish
+	importsDir string
+	// uploadsDir keeps the resumable uploads until they are finished or expire after uploadExpiry
+	uploadsDir   string
+	uploadExpiry time.Duration
+	uploadLocks  uploadLocks
+	// maxUploadSize limits the uploaded file, decompressed when the request is gzip encoded
+	maxUploadSize int64
+	// jobsCtx lives as long as the gateway, import jobs run in the background until it is done
+	jobsCtx context.Context
+	jobs    sync.WaitGroup
 }
 
-// NewServer creates new PortServer instance
-func NewServer(portsConn *grpc.ClientConn) *PortServer {
+// NewServer creates new PortServer instance, uploads are kept in importsDir until imported,
+// the resumable ones expire when they get no data for uploadExpiry, the uploaded files are limited to maxUploadSize
+func NewServer(ctx context.Context, portsConn *grpc.ClientConn, importsDir string, uploadExpiry time.Duration, maxUploadSize int64) (*PortServer, error) {
 	client := pb.NewPortsServiceClient(portsConn)
 
-	return &PortServer{
-		portsConn:   portsConn,
-		portsClient: client,
+	uploadsDir := filepath.Join(importsDir, "uploads")
+	if err := os.MkdirAll(uploadsDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create imports dir: %s", err)
 	}
+
+	return &PortServer{
+		portsConn:     portsConn,
+		portsClient:   client,
+		importsDir:    importsDir,
+		uploadsDir:    uploadsDir,
+		uploadExpiry:  uploadExpiry,
+		maxUploadSize: maxUploadSize,
+		jobsCtx:       ctx,
+	}, nil
 }
 
 // Routes returns set of routes served by PortServer
-func Routes(portsConn *grpc.ClientConn) []router.Route {
-	srv := NewServer(portsConn)
-
+func Routes(srv *PortServer) []router.Route {
 	return []router.Route{
 		{
 			Method:  http.MethodGet,
@@ -49,16 +82,46 @@ func Routes(portsConn *grpc.ClientConn) []router.Route {
 			Path:    "/ports",
 			Handler: srv.CreatePort,
 		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/ports/lookup",
+			Handler: srv.LookupPorts,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/ports/export",
+			Handler: srv.ExportPorts,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/ports/nearby",
+			Handler: srv.FindNearestPorts,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/ports/search",
+			Handler: srv.SearchPorts,
+		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/ports/{idOrSlug}",
 			Handler: srv.FetchPort,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/ports/{idOrSlug}/history",
+			Handler: srv.GetPortHistory,
+		},
 		{
 			Method:  http.MethodPut,
 			Path:    "/ports/{idOrSlug}",
 			Handler: srv.UpdatePort,
 		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/ports/{idOrSlug}",
+			Handler: srv.PatchPort,
+		},
 		{
 			Method:  http.MethodDelete,
 			Path:    "/ports/{idOrSlug}",
@@ -69,17 +132,86 @@ func Routes(portsConn *grpc.ClientConn) []router.Route {
 			Path:    "/upload-ports",
 			Handler: srv.UploadPortsState,
 		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/uploads",
+			Handler: srv.CreateUpload,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/uploads/{id}",
+			Handler: srv.GetUpload,
+		},
+		{
+			Method:  http.MethodHead,
+			Path:    "/uploads/{id}",
+			Handler: srv.GetUpload,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/uploads/{id}",
+			Handler: srv.AppendUpload,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/uploads/{id}/finish",
+			Handler: srv.FinishUpload,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/uploads/{id}",
+			Handler: srv.DeleteUpload,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/imports",
+			Handler: srv.ListImportJobs,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/imports/{id}",
+			Handler: srv.GetImportJob,
+		},
 	}
 }
 
 // ListPorts returns array of Port to the client
+// supported query parameters are page_size, page_token, country, province, region, timezone, code_prefix,
+// order_by (id, slug, name, city, country), order (asc, desc) and as_of (RFC 3339 time)
+// when there are more ports to fetch the Link header contains the url of the next page
+// ids, slugs or unlocks query parameters fetch the ports by them at once instead, see LookupPorts
 func (s *PortServer) ListPorts(w http.ResponseWriter, r *http.Request) {
-	res, err := s.portsClient.ListPorts(r.Context(), &pb.ListPortsRequest{})
+	if isLookupQuery(r.URL.Query()) {
+		lookup, err := lookupFromQuery(r.URL.Query())
+		if err != nil {
+			respondBadRequest(err.Error(), w)
+			return
+		}
+
+		s.lookupPorts(lookup, w, r)
+		return
+	}
+
+	req, err := listRequestFromQuery(r.URL.Query())
 	if err != nil {
-		respondError(err.Error(), w)
+		respondBadRequest(err.Error(), w)
 		return
 	}
 
+	res, err := s.portsClient.ListPorts(r.Context(), req)
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	if res != nil && res.NextPageToken != "" {
+		next := *r.URL
+		query := next.Query()
+		query.Set("page_token", res.NextPageToken)
+		next.RawQuery = query.Encode()
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.RequestURI()))
+	}
+
 	if res != nil && res.Data != nil {
 		ports := make([]Port, 0, len(res.Data))
 		for _, v := range res.Data {
@@ -104,22 +236,158 @@ func (s *PortServer) ListPorts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func listRequestFromQuery(query url.Values) (*pb.ListPortsRequest, error) {
+	req := &pb.ListPortsRequest{
+		PageToken:  query.Get("page_token"),
+		Country:    query.Get("country"),
+		Province:   query.Get("province"),
+		Region:     query.Get("region"),
+		Timezone:   query.Get("timezone"),
+		CodePrefix: query.Get("code_prefix"),
+		OrderBy:    query.Get("order_by"),
+	}
+
+	asOf, err := parseAsOf(query.Get("as_of"))
+	if err != nil {
+		return nil, err
+	}
+	req.AsOf = asOf
+
+	if size := query.Get("page_size"); size != "" {
+		n, err := strconv.ParseInt(size, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("wrong page_size provided: %s", size)
+		}
+		req.PageSize = int32(n)
+	}
+
+	switch order := query.Get("order"); order {
+	case "", "asc":
+	case "desc":
+		req.Descending = true
+	default:
+		return nil, fmt.Errorf("wrong order provided: %s", order)
+	}
+
+	return req, nil
+}
+
+// FindNearestPorts returns ports closest to the lat/lng location ordered by distance
+// optional query parameters are radius_km, limit and country
+func (s *PortServer) FindNearestPorts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var (
+		req      = &pb.NearestPortsRequest{Country: query.Get("country")}
+		lat, lng float64
+		err      error
+	)
+
+	if lat, err = strconv.ParseFloat(query.Get("lat"), 64); err != nil {
+		respondBadRequest(fmt.Sprintf("wrong lat provided: %s", query.Get("lat")), w)
+		return
+	}
+
+	if lng, err = strconv.ParseFloat(query.Get("lng"), 64); err != nil {
+		respondBadRequest(fmt.Sprintf("wrong lng provided: %s", query.Get("lng")), w)
+		return
+	}
+	req.Location = &pb.Coordinates{Lat: lat, Lng: lng}
+
+	if radius := query.Get("radius_km"); radius != "" {
+		if req.RadiusKm, err = strconv.ParseFloat(radius, 64); err != nil {
+			respondBadRequest(fmt.Sprintf("wrong radius_km provided: %s", radius), w)
+			return
+		}
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil {
+			respondBadRequest(fmt.Sprintf("wrong limit provided: %s", limit), w)
+			return
+		}
+		req.Limit = int32(n)
+	}
+
+	res, err := s.portsClient.FindNearestPorts(r.Context(), req)
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	ports := make([]NearbyPort, 0, len(res.Data))
+	for _, v := range res.Data {
+		ports = append(ports, NearbyPort{
+			Port:       fromPbPort(v.Port),
+			DistanceKm: v.DistanceKm,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ports); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+		respondError(err.Error(), w)
+
+		return
+	}
+}
+
+// SearchPorts returns ports fuzzy matching the q query parameter ordered by relevance
+// optional limit query parameter limits the number of results
+func (s *PortServer) SearchPorts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &pb.SearchPortsRequest{Q: query.Get("q")}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil {
+			respondBadRequest(fmt.Sprintf("wrong limit provided: %s", limit), w)
+			return
+		}
+		req.Limit = int32(n)
+	}
+
+	res, err := s.portsClient.SearchPorts(r.Context(), req)
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	ports := make([]MatchedPort, 0, len(res.Data))
+	for _, v := range res.Data {
+		ports = append(ports, MatchedPort{
+			Port:  fromPbPort(v.Port),
+			Score: v.Score,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ports); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+		respondError(err.Error(), w)
+
+		return
+	}
+}
+
 // CreatePort creates new Port record
 func (s *PortServer) CreatePort(w http.ResponseWriter, r *http.Request) {
 	r.Body = http.MaxBytesReader(w, r.Body, 1048576) // 1MB limit just in case...
 	dec := json.NewDecoder(r.Body)
 	var port Port
 	if err := dec.Decode(&port); err != nil {
-		respondError(err.Error(), w)
+		respondBadRequest(err.Error(), w)
 		return
 	}
 
 	res, err := s.portsClient.CreatePort(r.Context(), &pb.CreatePortRequest{Data: toPbPort(port)})
 	if err != nil {
-		respondError(err.Error(), w)
+		respondGRPCError(err, w)
 		return
 	}
 
+	setWarnings(res.Warnings, w)
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(fromPbPort(res.Data)); err != nil {
 		fmt.Printf("error encoding response payload. err: %v", err)
@@ -132,6 +400,7 @@ func (s *PortServer) CreatePort(w http.ResponseWriter, r *http.Request) {
 }
 
 // UpdatePort updates existing Port record
+// If-Match header with the port ETag makes the update fail with 412 when the port was changed in the meantime
 func (s *PortServer) UpdatePort(w http.ResponseWriter, r *http.Request) {
 	idOrSlug := chi.URLParam(r, "idOrSlug")
 	var (
@@ -140,7 +409,7 @@ func (s *PortServer) UpdatePort(w http.ResponseWriter, r *http.Request) {
 	)
 
 	if len(idOrSlug) == 0 {
-		respondError("wrong identifier or slug provided", w)
+		respondBadRequest("wrong identifier or slug provided", w)
 		return
 	}
 
@@ -154,7 +423,7 @@ func (s *PortServer) UpdatePort(w http.ResponseWriter, r *http.Request) {
 	dec := json.NewDecoder(r.Body)
 	var port Port
 	if err := dec.Decode(&port); err != nil {
-		respondError(err.Error(), w)
+		respondBadRequest(err.Error(), w)
 		return
 	}
 
@@ -167,13 +436,26 @@ func (s *PortServer) UpdatePort(w http.ResponseWriter, r *http.Request) {
 		updReq.Slug = &wrappers.StringValue{Value: *slug}
 	}
 
+	expected, err := parseIfMatch(r.Header.Get("If-Match"))
+	if err != nil {
+		respondPreconditionFailed(err.Error(), w)
+		return
+	}
+	updReq.ExpectedVersion = expected
+
 	updReq.Data = toPbPortUpdatable(port)
 	res, err := s.portsClient.UpdatePort(r.Context(), updReq)
 	if err != nil {
-		respondError(err.Error(), w)
+		if status.Code(err) == codes.FailedPrecondition {
+			respondPreconditionFailed(status.Convert(err).Message(), w)
+			return
+		}
+		respondGRPCError(err, w)
 		return
 	}
 
+	w.Header().Set("ETag", versionETag(res.Data.Version))
+	setWarnings(res.Warnings, w)
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(fromPbPort(res.Data)); err != nil {
 		fmt.Printf("error encoding response payload. err: %v", err)
@@ -184,6 +466,8 @@ func (s *PortServer) UpdatePort(w http.ResponseWriter, r *http.Request) {
 }
 
 // FetchPort returns existing Port record by id or slug
+// optional as_of query parameter (RFC 3339 time) returns the port as it was at that time
+// the response has ETag header with the port version to be used in If-Match header of updates and deletes
 func (s *PortServer) FetchPort(w http.ResponseWriter, r *http.Request) {
 	idOrSlug := chi.URLParam(r, "idOrSlug")
 	var (
@@ -192,7 +476,7 @@ func (s *PortServer) FetchPort(w http.ResponseWriter, r *http.Request) {
 	)
 
 	if len(idOrSlug) == 0 {
-		respondError("wrong identifier or slug provided", w)
+		respondBadRequest("wrong identifier or slug provided", w)
 		return
 	}
 
@@ -211,9 +495,23 @@ func (s *PortServer) FetchPort(w http.ResponseWriter, r *http.Request) {
 		req.Slug = &wrappers.StringValue{Value: *slug}
 	}
 
+	asOf, err := parseAsOf(r.URL.Query().Get("as_of"))
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+	req.AsOf = asOf
+
 	res, err := s.portsClient.FetchPort(r.Context(), req)
 	if err != nil {
-		respondError(err.Error(), w)
+		respondGRPCError(err, w)
+		return
+	}
+
+	etag := versionETag(res.Data.Version)
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
@@ -226,7 +524,36 @@ func (s *PortServer) FetchPort(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetPortHistory returns every change of the Port record by id or slug
+func (s *PortServer) GetPortHistory(w http.ResponseWriter, r *http.Request) {
+	id, slug, err := parseIDOrSlug(r)
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	res, err := s.portsClient.GetPortHistory(r.Context(), &pb.PortHistoryRequest{Id: id, Slug: slug})
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	changes := make([]PortChange, 0, len(res.Data))
+	for _, v := range res.Data {
+		changes = append(changes, fromPbPortChange(v))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(changes); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+		respondError(err.Error(), w)
+
+		return
+	}
+}
+
 // DeletePort deletes existing Port record by id or slug
+// If-Match header with the port ETag makes the deletion fail with 412 when the port was changed in the meantime
 func (s *PortServer) DeletePort(w http.ResponseWriter, r *http.Request) {
 	idOrSlug := chi.URLParam(r, "idOrSlug")
 	var (
@@ -235,7 +562,7 @@ func (s *PortServer) DeletePort(w http.ResponseWriter, r *http.Request) {
 	)
 
 	if len(idOrSlug) == 0 {
-		respondError("wrong identifier or slug provided", w)
+		respondBadRequest("wrong identifier or slug provided", w)
 		return
 	}
 
@@ -251,91 +578,20 @@ func (s *PortServer) DeletePort(w http.ResponseWriter, r *http.Request) {
 		req.Slug = &wrappers.StringValue{Value: *slug}
 	}
 
-	_, err := s.portsClient.DeletePort(r.Context(), req)
-	if err != nil {
-		respondError(err.Error(), w)
-		return
-	}
-}
-
-// UploadPortsState allows you to upload file with Port instances and create or update existing Port by slug
-// it should have a json object structure
-// {
-//    "PORT1": {
-//      ...
-//    },
-//    "PORT2": {
-//      ...
-//    },
-// }
-func (s *PortServer) UploadPortsState(w http.ResponseWriter, r *http.Request) {
-	//go mem.PrintUsage()
-	_ = r.ParseMultipartForm(MaxMemoryAllowed) // 10MB
-	file, _, err := r.FormFile("file")
+	expected, err := parseIfMatch(r.Header.Get("If-Match"))
 	if err != nil {
-		respondError(err.Error(), w)
+		respondPreconditionFailed(err.Error(), w)
 		return
 	}
-	defer func() { _ = file.Close() }()
+	req.ExpectedVersion = expected
 
-	parser := jsonparser.New(MaxMemoryAllowed, ChunkSize) // 10MB and 20 ports at once
-	portsChan, errsChan, err := parser.ParseFile(file)
+	_, err = s.portsClient.DeletePort(r.Context(), req)
 	if err != nil {
-		respondError(err.Error(), w)
-		return
-	}
-
-	var parseErr bool
-	var finished bool
-	for {
-		if parseErr || finished {
-			break
-		}
-
-		select {
-		case data, ok := <-portsChan:
-			if !ok && len(data) == 0 {
-				finished = true
-				break
-			}
-
-			ports := make([]Port, 0, len(data))
-			for slug, portData := range data {
-				bytes, err := json.Marshal(portData)
-				if err != nil {
-					parseErr = true
-					fmt.Println(err)
-					break
-				}
-
-				var p Port
-				if err := json.Unmarshal(bytes, &p); err != nil {
-					parseErr = true
-					fmt.Println(err)
-					break
-				}
-				p.Slug = slug
-
-				ports = append(ports, p)
-			}
-
-			_, err := s.portsClient.CreateOrUpdatePortBulk(r.Context(), &pb.UpsertPortBulkRequest{Data: toPbPorts(ports)})
-			if err != nil {
-				respondError(err.Error(), w)
-				return
-			}
-
-			if !ok {
-				finished = true
-				break
-			}
-		case _ = <-errsChan:
-			parseErr = true
-			break
+		if status.Code(err) == codes.FailedPrecondition {
+			respondPreconditionFailed(status.Convert(err).Message(), w)
+			return
 		}
-	}
-	if parseErr {
-		respondInternal(w)
+		respondGRPCError(err, w)
 		return
 	}
 }
@@ -354,6 +610,53 @@ type Port struct {
 	Timezone    string    `json:"timezone"`
 	Unlocks     []string  `json:"unlocks"`
 	Code        string    `json:"code"`
+	Version     int64     `json:"version"`
+}
+
+// NearbyPort is a Port with its distance from the searched location
+type NearbyPort struct {
+	Port
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// MatchedPort is a Port found by the search with its relevance score
+type MatchedPort struct {
+	Port
+	Score float64 `json:"score"`
+}
+
+// PortChange is a single versioned change of the Port
+type PortChange struct {
+	Version   int64     `json:"version"`
+	Operation string    `json:"operation"`
+	OldValue  *Port     `json:"old_value"`
+	NewValue  *Port     `json:"new_value"`
+	Actor     string    `json:"actor"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func fromPbPortChange(proto *pb.PortChange) PortChange {
+	change := PortChange{
+		Version:   proto.Version,
+		Operation: proto.Operation,
+		Actor:     proto.Actor,
+	}
+
+	if proto.OldValue != nil {
+		port := fromPbPort(proto.OldValue)
+		change.OldValue = &port
+	}
+
+	if proto.NewValue != nil {
+		port := fromPbPort(proto.NewValue)
+		change.NewValue = &port
+	}
+
+	if changedAt, err := ptypes.Timestamp(proto.ChangedAt); err == nil {
+		change.ChangedAt = changedAt
+	}
+
+	return change
 }
 
 func fromPbPort(proto *pb.Port) Port {
@@ -372,6 +675,7 @@ func fromPbPort(proto *pb.Port) Port {
 		Timezone: proto.Timezone,
 		Unlocks:  proto.Unlocks,
 		Code:     proto.Code,
+		Version:  proto.Version,
 	}
 
 	if proto.Id != nil {
@@ -448,6 +752,67 @@ func toPbPortUpdatable(port Port) *pb.PortUpdatable {
 	return proto
 }
 
+// parseIDOrSlug reads idOrSlug url parameter, numeric values are treated as ids
+func parseIDOrSlug(r *http.Request) (*wrappers.Int64Value, *wrappers.StringValue, error) {
+	idOrSlug := chi.URLParam(r, "idOrSlug")
+	if len(idOrSlug) == 0 {
+		return nil, nil, errors.New("wrong identifier or slug provided")
+	}
+
+	if n, err := strconv.ParseInt(idOrSlug, 10, 64); err == nil {
+		return &wrappers.Int64Value{Value: n}, nil, nil
+	}
+
+	return nil, &wrappers.StringValue{Value: idOrSlug}, nil
+}
+
+// parseAsOf reads RFC 3339 time of the as_of query parameter, empty value means the current state
+func parseAsOf(value string) (*timestamp.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("wrong as_of provided: %s", value)
+	}
+
+	return ptypes.TimestampProto(t)
+}
+
+// versionETag builds strong ETag value out of the port version
+func versionETag(version int64) string {
+	return fmt.Sprintf("\"%d\"", version)
+}
+
+// setWarnings adds Warning header for every domain rule the stored port breaks,
+// the ports service reports them when it validates the ports leniently
+func setWarnings(warnings []*pb.FieldError, w http.ResponseWriter) {
+	for _, warning := range warnings {
+		w.Header().Add("Warning", fmt.Sprintf("199 - %q", warning.Field+": "+warning.Reason))
+	}
+}
+
+// parseIfMatch reads the port version out of If-Match header value, empty value and * mean any version
+func parseIfMatch(value string) (*wrappers.Int64Value, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return nil, fmt.Errorf("wrong If-Match provided: %s", value)
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("wrong If-Match provided: %s", value)
+	}
+
+	return &wrappers.Int64Value{Value: version}, nil
+}
+
 func toWString(s string) *wrappers.StringValue {
 	if s != "" {
 		return &wrappers.StringValue{Value: s}
@@ -457,13 +822,20 @@ func toWString(s string) *wrappers.StringValue {
 }
 
 func respondError(message string, w http.ResponseWriter) {
-	payload := map[string]string{
-		"message": message,
-	}
-	w.WriteHeader(http.StatusInternalServerError)
-	if err := json.NewEncoder(w).Encode(payload); err != nil {
-		fmt.Printf("error encoding response payload. err: %v", err)
+	respondStatus(http.StatusInternalServerError, message, w)
+}
+
+func respondPreconditionFailed(message string, w http.ResponseWriter) {
+	respondStatus(http.StatusPreconditionFailed, message, w)
+}
+
+func respondStatus(code int, message string, w http.ResponseWriter) {
+	problem, ok := problemCodes[code]
+	if !ok {
+		problem = problemCodes[http.StatusInternalServerError]
 	}
+
+	respondProblem(code, problem, message, w)
 }
 
 func respondInternal(w http.ResponseWriter) {
@@ -0,0 +1,100 @@
+package ports
+
+import (
+	"context"
+	"github.com/go-chi/chi"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// portsClientMock answers the calls of the handlers with the funcs the test sets, the other calls panic
+type portsClientMock struct {
+	pb.PortsServiceClient
+	fetchPort       func(req *pb.PortRequest) (*pb.PortResponse, error)
+	updatePort      func(req *pb.UpdatePortRequest) (*pb.PortResponse, error)
+	createImportJob func(req *pb.CreateImportJobRequest) (*pb.ImportJobResponse, error)
+	updateImportJob func(req *pb.UpdateImportJobRequest) (*pb.ImportJobResponse, error)
+	exportPorts     func(req *pb.ExportPortsRequest) (pb.PortsService_ExportPortsClient, error)
+	batchGetPorts   func(req *pb.BatchGetPortsRequest) (*pb.BatchGetPortsResponse, error)
+}
+
+func (m *portsClientMock) FetchPort(_ context.Context, req *pb.PortRequest, _ ...grpc.CallOption) (*pb.PortResponse, error) {
+	return m.fetchPort(req)
+}
+
+func (m *portsClientMock) UpdatePort(_ context.Context, req *pb.UpdatePortRequest, _ ...grpc.CallOption) (*pb.PortResponse, error) {
+	return m.updatePort(req)
+}
+
+func (m *portsClientMock) CreateImportJob(_ context.Context, req *pb.CreateImportJobRequest, _ ...grpc.CallOption) (*pb.ImportJobResponse, error) {
+	return m.createImportJob(req)
+}
+
+func (m *portsClientMock) UpdateImportJob(_ context.Context, req *pb.UpdateImportJobRequest, _ ...grpc.CallOption) (*pb.ImportJobResponse, error) {
+	return m.updateImportJob(req)
+}
+
+func (m *portsClientMock) ExportPorts(_ context.Context, req *pb.ExportPortsRequest, _ ...grpc.CallOption) (pb.PortsService_ExportPortsClient, error) {
+	return m.exportPorts(req)
+}
+
+func (m *portsClientMock) BatchGetPorts(_ context.Context, req *pb.BatchGetPortsRequest, _ ...grpc.CallOption) (*pb.BatchGetPortsResponse, error) {
+	return m.batchGetPorts(req)
+}
+
+// serve sends the request to the routes of the server using the client
+func serve(t *testing.T, client pb.PortsServiceClient, req *http.Request) *httptest.ResponseRecorder {
+	return route(newTestServer(t, client), req)
+}
+
+// newTestServer returns the server using the client with its imports dir in the test temp dir
+func newTestServer(t *testing.T, client pb.PortsServiceClient) *PortServer {
+	importsDir := t.TempDir()
+	uploadsDir := filepath.Join(importsDir, "uploads")
+	require.NoError(t, os.Mkdir(uploadsDir, 0755))
+
+	return &PortServer{
+		portsClient:   client,
+		importsDir:    importsDir,
+		uploadsDir:    uploadsDir,
+		uploadExpiry:  time.Hour,
+		maxUploadSize: 1 << 20,
+		jobsCtx:       context.Background(),
+	}
+}
+
+// route sends the request to the routes of the server
+func route(srv *PortServer, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handle(srv, rec, req)
+
+	return rec
+}
+
+// handle serves the request with the routes of the server
+func handle(srv *PortServer, w http.ResponseWriter, req *http.Request) {
+	mux := chi.NewRouter()
+	for _, route := range Routes(srv) {
+		mux.MethodFunc(route.Method, route.Path, route.Handler)
+	}
+
+	mux.ServeHTTP(w, req)
+}
+
+// newRequest builds the request with the body of the given content type
+func newRequest(method, target, contentType, body string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	return req
+}
@@ -0,0 +1,152 @@
+package ports
+
+import (
+	"encoding/json"
+	"fmt"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+// problemContentType is the content type of the problem details bodies
+const problemContentType = "application/problem+json"
+
+// Problem is the problem details body (RFC 7807) of every failed request,
+// Code tells the errors apart without parsing Detail
+type Problem struct {
+	Type       string      `json:"type"`
+	Title      string      `json:"title"`
+	Status     int         `json:"status"`
+	Detail     string      `json:"detail,omitempty"`
+	Code       string      `json:"code"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Violation is the invalid field of the request, Field is the path of it like coordinates.lat
+// and Rule names the validation rule it breaks like required, pattern or range
+type Violation struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// grpcStatuses maps the status codes of the ports service to http statuses, the rest are 500
+var grpcStatuses = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.FailedPrecondition: http.StatusConflict,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+}
+
+// problemCodes are the codes of the problems the gateway finds on its own, by their http statuses
+var problemCodes = map[int]string{
+	http.StatusBadRequest:            "invalid_argument",
+	http.StatusNotFound:              "not_found",
+	http.StatusConflict:              "conflict",
+	http.StatusPreconditionFailed:    "precondition_failed",
+	http.StatusRequestEntityTooLarge: "payload_too_large",
+	http.StatusUnsupportedMediaType:  "unsupported_media_type",
+	http.StatusInternalServerError:   "internal",
+}
+
+// respondGRPCError responds with the http status of the grpc status code, the code gives the problem code
+// like not_found or invalid_argument, errors without grpc status are internal ones
+func respondGRPCError(err error, w http.ResponseWriter) {
+	st, ok := status.FromError(err)
+	if !ok {
+		respondError(err.Error(), w)
+		return
+	}
+
+	code, ok := grpcStatuses[st.Code()]
+	if !ok {
+		respondError(st.Message(), w)
+		return
+	}
+
+	respondProblem(code, problemCode(st.Code()), st.Message(), w, violations(st)...)
+}
+
+func respondBadRequest(message string, w http.ResponseWriter) {
+	respondStatus(http.StatusBadRequest, message, w)
+}
+
+func respondProblem(code int, problem, detail string, w http.ResponseWriter, violations ...Violation) {
+	payload := Problem{
+		Type:       "about:blank",
+		Title:      http.StatusText(code),
+		Status:     code,
+		Detail:     detail,
+		Code:       problem,
+		Violations: violations,
+	}
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+	}
+}
+
+// violations reads the invalid fields from the details of the status, FieldErrors details name the rules they break,
+// the fields of BadRequest details alone break the invalid rule,
+// the port fields of the request are named the same as in the body, without the data prefix
+func violations(st *status.Status) []Violation {
+	var (
+		res        []Violation
+		badRequest []Violation
+	)
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *pb.FieldErrors:
+			for _, fe := range d.Errors {
+				res = append(res, Violation{
+					Field:   strings.TrimPrefix(fe.Field, "data."),
+					Rule:    fe.Rule,
+					Message: fe.Reason,
+				})
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				badRequest = append(badRequest, Violation{
+					Field:   strings.TrimPrefix(v.Field, "data."),
+					Rule:    "invalid",
+					Message: v.Description,
+				})
+			}
+		}
+	}
+
+	if res == nil {
+		return badRequest
+	}
+
+	return res
+}
+
+// problemCode turns the grpc code name into the problem code, InvalidArgument becomes invalid_argument
+func problemCode(code codes.Code) string {
+	var b strings.Builder
+	for i, r := range code.String() {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,180 @@
+package ports
+
+import (
+	"encoding/json"
+	"errors"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRespondGRPCError(t *testing.T) {
+	cases := []struct {
+		err     error
+		status  int
+		problem string
+	}{
+		{err: status.Error(codes.InvalidArgument, "failed"), status: http.StatusBadRequest, problem: "invalid_argument"},
+		{err: status.Error(codes.OutOfRange, "failed"), status: http.StatusBadRequest, problem: "out_of_range"},
+		{err: status.Error(codes.NotFound, "failed"), status: http.StatusNotFound, problem: "not_found"},
+		{err: status.Error(codes.AlreadyExists, "failed"), status: http.StatusConflict, problem: "already_exists"},
+		{err: status.Error(codes.Aborted, "failed"), status: http.StatusConflict, problem: "aborted"},
+		{err: status.Error(codes.FailedPrecondition, "failed"), status: http.StatusConflict, problem: "failed_precondition"},
+		{err: status.Error(codes.Unauthenticated, "failed"), status: http.StatusUnauthorized, problem: "unauthenticated"},
+		{err: status.Error(codes.PermissionDenied, "failed"), status: http.StatusForbidden, problem: "permission_denied"},
+		{err: status.Error(codes.ResourceExhausted, "failed"), status: http.StatusTooManyRequests, problem: "resource_exhausted"},
+		{err: status.Error(codes.Unimplemented, "failed"), status: http.StatusNotImplemented, problem: "unimplemented"},
+		{err: status.Error(codes.Unavailable, "failed"), status: http.StatusServiceUnavailable, problem: "unavailable"},
+		{err: status.Error(codes.DeadlineExceeded, "failed"), status: http.StatusGatewayTimeout, problem: "deadline_exceeded"},
+		{err: status.Error(codes.Canceled, "failed"), status: http.StatusInternalServerError, problem: "internal"},
+		{err: status.Error(codes.Unknown, "failed"), status: http.StatusInternalServerError, problem: "internal"},
+		{err: status.Error(codes.Internal, "failed"), status: http.StatusInternalServerError, problem: "internal"},
+		{err: status.Error(codes.DataLoss, "failed"), status: http.StatusInternalServerError, problem: "internal"},
+		{err: errors.New("failed"), status: http.StatusInternalServerError, problem: "internal"},
+	}
+
+	for _, test := range cases {
+		t.Run(status.Code(test.err).String(), func(t *testing.T) {
+			r := require.New(t)
+
+			rec := httptest.NewRecorder()
+			respondGRPCError(test.err, rec)
+
+			r.Equal(test.status, rec.Code)
+			r.Equal("application/problem+json", rec.Header().Get("Content-Type"))
+
+			var body map[string]interface{}
+			r.NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+			r.Equal(map[string]interface{}{
+				"type":   "about:blank",
+				"title":  http.StatusText(test.status),
+				"status": float64(test.status),
+				"detail": "failed",
+				"code":   test.problem,
+			}, body)
+		})
+	}
+}
+
+func TestRespondGRPCErrorViolations(t *testing.T) {
+	r := require.New(t)
+
+	st, err := status.New(codes.InvalidArgument, "invalid port").WithDetails(&pb.FieldErrors{Errors: []*pb.FieldError{
+		{Field: "data.slug", Reason: "value length must be 5 runes", Rule: "len"},
+	}})
+	r.NoError(err)
+
+	rec := httptest.NewRecorder()
+	respondGRPCError(st.Err(), rec)
+
+	r.Equal(http.StatusBadRequest, rec.Code)
+	r.JSONEq(`{
+		"type": "about:blank",
+		"title": "Bad Request",
+		"status": 400,
+		"detail": "invalid port",
+		"code": "invalid_argument",
+		"violations": [{"field": "slug", "rule": "len", "message": "value length must be 5 runes"}]
+	}`, rec.Body.String())
+}
+
+func TestRespondStatus(t *testing.T) {
+	cases := []struct {
+		status  int
+		problem string
+	}{
+		{status: http.StatusBadRequest, problem: "invalid_argument"},
+		{status: http.StatusNotFound, problem: "not_found"},
+		{status: http.StatusConflict, problem: "conflict"},
+		{status: http.StatusPreconditionFailed, problem: "precondition_failed"},
+		{status: http.StatusRequestEntityTooLarge, problem: "payload_too_large"},
+		{status: http.StatusUnsupportedMediaType, problem: "unsupported_media_type"},
+		{status: http.StatusInternalServerError, problem: "internal"},
+		{status: http.StatusTeapot, problem: "internal"},
+	}
+
+	for _, test := range cases {
+		t.Run(http.StatusText(test.status), func(t *testing.T) {
+			r := require.New(t)
+
+			rec := httptest.NewRecorder()
+			respondStatus(test.status, "failed", rec)
+
+			r.Equal(test.status, rec.Code)
+			r.Equal("application/problem+json", rec.Header().Get("Content-Type"))
+
+			var problem Problem
+			r.NoError(json.Unmarshal(rec.Body.Bytes(), &problem))
+			r.Equal(Problem{
+				Type:   "about:blank",
+				Title:  http.StatusText(test.status),
+				Status: test.status,
+				Detail: "failed",
+				Code:   test.problem,
+			}, problem)
+		})
+	}
+}
+
+func TestViolations(t *testing.T) {
+	badRequest := &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+		{Field: "data.slug", Description: "value length must be 5 runes"},
+		{Field: "data.coordinates.lat", Description: "value must be inside range [-90, 90]"},
+	}}
+	fieldErrors := &pb.FieldErrors{Errors: []*pb.FieldError{
+		{Field: "data.slug", Reason: "value length must be 5 runes", Rule: "len"},
+		{Field: "data.coordinates.lat", Reason: "value must be inside range [-90, 90]", Rule: "range"},
+	}}
+
+	cases := []struct {
+		name       string
+		badRequest bool
+		withRules  bool
+		violations []Violation
+	}{
+		{
+			name:       "several invalid fields",
+			badRequest: true,
+			withRules:  true,
+			violations: []Violation{
+				{Field: "slug", Rule: "len", Message: "value length must be 5 runes"},
+				{Field: "coordinates.lat", Rule: "range", Message: "value must be inside range [-90, 90]"},
+			},
+		},
+		{
+			name:       "bad request without rules",
+			badRequest: true,
+			violations: []Violation{
+				{Field: "slug", Rule: "invalid", Message: "value length must be 5 runes"},
+				{Field: "coordinates.lat", Rule: "invalid", Message: "value must be inside range [-90, 90]"},
+			},
+		},
+		{
+			name: "no details",
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+
+			var err error
+			st := status.New(codes.InvalidArgument, "invalid port")
+			if test.badRequest {
+				st, err = st.WithDetails(badRequest)
+				r.NoError(err)
+			}
+			if test.withRules {
+				st, err = st.WithDetails(fieldErrors)
+				r.NoError(err)
+			}
+
+			r.Equal(test.violations, violations(st))
+		})
+	}
+}
@@ -0,0 +1,501 @@
+package ports
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// uploadIDSize is the number of random bytes of the upload id
+	uploadIDSize = 16
+	// uploadSweepInterval is how often the expired uploads are removed
+	uploadSweepInterval = time.Minute
+)
+
+var (
+	errUploadNotFound = errors.New("upload not found")
+	errUploadBusy     = errors.New("upload is being written by another request")
+)
+
+// Upload is the resumable upload of the ports file, its data is appended chunk by chunk until it is finished
+type Upload struct {
+	ID             string            `json:"id"`
+	FileName       string            `json:"file_name"`
+	Mode           string            `json:"mode"`
+	DryRun         bool              `json:"dry_run"`
+	Format         string            `json:"format"`
+	SlugProperty   string            `json:"slug_property,omitempty"`
+	Loader         string            `json:"loader"`
+	ConflictPolicy string            `json:"conflict_policy"`
+	FieldPolicies  map[string]string `json:"field_policies,omitempty"`
+	Offset         int64             `json:"offset"`
+	Length         *int64            `json:"length,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	ExpiresAt      time.Time         `json:"expires_at"`
+}
+
+// uploadLocks keeps the uploads being written, so a single request at a time appends to the upload
+type uploadLocks struct {
+	mu   sync.Mutex
+	busy map[string]bool
+}
+
+// lock marks the upload busy, it fails when the upload is busy already
+func (l *uploadLocks) lock(id string) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.busy[id] {
+		return nil, errUploadBusy
+	}
+
+	if l.busy == nil {
+		l.busy = map[string]bool{}
+	}
+	l.busy[id] = true
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.busy, id)
+	}, nil
+}
+
+// CreateUpload starts the resumable upload, it takes the parameters of POST /upload-ports from the query or the form
+// and file_name instead of the file, the format of the file is told by its name
+// Upload-Length header is the size of the whole file, when it is known the upload is finished only once it is complete
+// the response is 201 with the upload and its url in the Location header
+func (s *PortServer) CreateUpload(w http.ResponseWriter, r *http.Request) {
+	mode, dryRun, err := parseImportOptions(r)
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	format, err := parseImportFormat(r.FormValue("format"))
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	loader, err := parseImportLoader(r.FormValue("loader"))
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	conflicts, err := parseConflicts(r.FormValue("conflict_policy"), r.FormValue("field_policies"))
+	if err != nil {
+		respondBadRequest(err.Error(), w)
+		return
+	}
+
+	job := fromPbImportJob(&pb.ImportJob{Mode: mode, Format: format, Loader: loader, Conflicts: conflicts})
+
+	id := make([]byte, uploadIDSize)
+	if _, err := rand.Read(id); err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+
+	now := time.Now().UTC()
+	upload := Upload{
+		ID:             hex.EncodeToString(id),
+		FileName:       uploadFileName(r.FormValue("file_name")),
+		Mode:           job.Mode,
+		DryRun:         dryRun,
+		Format:         job.Format,
+		SlugProperty:   strings.TrimSpace(r.FormValue("slug_property")),
+		Loader:         job.Loader,
+		ConflictPolicy: job.ConflictPolicy,
+		FieldPolicies:  job.FieldPolicies,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(s.uploadExpiry),
+	}
+
+	if value := r.Header.Get("Upload-Length"); value != "" {
+		length, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || length < 0 {
+			respondBadRequest(fmt.Sprintf("wrong Upload-Length provided: %s", value), w)
+			return
+		}
+		upload.Length = &length
+	}
+
+	data, err := os.OpenFile(s.uploadDataPath(upload.ID), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+	if err := data.Close(); err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+
+	if err := s.saveUpload(upload); err != nil {
+		_ = os.Remove(s.uploadDataPath(upload.ID))
+		respondError(err.Error(), w)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/uploads/%s", upload.ID))
+	respondUpload(http.StatusCreated, upload, w)
+}
+
+// GetUpload returns the upload with the offset the next chunk should be appended at,
+// the offset and the length are sent in Upload-Offset and Upload-Length headers as well, so HEAD tells them too
+func (s *PortServer) GetUpload(w http.ResponseWriter, r *http.Request) {
+	upload, err := s.loadUpload(chi.URLParam(r, "id"))
+	if err != nil {
+		respondUploadError(err, w)
+		return
+	}
+
+	respondUpload(http.StatusOK, upload, w)
+}
+
+// AppendUpload appends the request body to the upload, Upload-Offset header must be the current offset of the upload,
+// the response is 409 with the current offset otherwise. The data received before the connection drops is kept,
+// so the upload is resumed from the offset returned by GET or HEAD. Every chunk extends the expiry of the upload
+func (s *PortServer) AppendUpload(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		respondBadRequest("wrong Upload-Offset provided", w)
+		return
+	}
+
+	unlock, err := s.uploadLocks.lock(id)
+	if err != nil {
+		respondUploadError(err, w)
+		return
+	}
+	defer unlock()
+
+	upload, err := s.loadUpload(id)
+	if err != nil {
+		respondUploadError(err, w)
+		return
+	}
+
+	if offset != upload.Offset {
+		w.Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+		respondStatus(http.StatusConflict, fmt.Sprintf("upload offset is %d", upload.Offset), w)
+		return
+	}
+
+	body := io.Reader(r.Body)
+	if upload.Length != nil {
+		// one byte more than the rest of the file tells the chunk is too long
+		body = io.LimitReader(r.Body, *upload.Length-upload.Offset+1)
+	}
+
+	data, err := os.OpenFile(s.uploadDataPath(id), os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+
+	written, copyErr := io.Copy(data, body)
+	if err := data.Close(); err != nil && copyErr == nil {
+		copyErr = err
+	}
+	upload.Offset += written
+
+	tooLong := upload.Length != nil && upload.Offset > *upload.Length
+	if tooLong {
+		// the file is kept as long as it was declared, the client may still finish it
+		upload.Offset = *upload.Length
+		if err := os.Truncate(s.uploadDataPath(id), upload.Offset); err != nil {
+			respondError(err.Error(), w)
+			return
+		}
+	}
+
+	upload.ExpiresAt = time.Now().UTC().Add(s.uploadExpiry)
+	if err := s.saveUpload(upload); err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+
+	w.Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	switch {
+	case tooLong:
+		respondStatus(http.StatusRequestEntityTooLarge, fmt.Sprintf("upload length is %d", *upload.Length), w)
+	case copyErr != nil:
+		respondError(fmt.Sprintf("upload is interrupted at %d: %s", upload.Offset, copyErr), w)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// FinishUpload starts the import job of the upload the same way POST /upload-ports does, the upload is gone then,
+// the upload of the known length is finished only once all of it is received
+func (s *PortServer) FinishUpload(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	unlock, err := s.uploadLocks.lock(id)
+	if err != nil {
+		respondUploadError(err, w)
+		return
+	}
+	defer unlock()
+
+	upload, err := s.loadUpload(id)
+	if err != nil {
+		respondUploadError(err, w)
+		return
+	}
+
+	if upload.Length != nil && upload.Offset != *upload.Length {
+		w.Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+		respondStatus(http.StatusConflict, fmt.Sprintf("upload is incomplete, %d of %d bytes received", upload.Offset, *upload.Length), w)
+		return
+	}
+
+	format, err := parseImportFormat(upload.Format)
+	if err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+
+	loader, err := parseImportLoader(upload.Loader)
+	if err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+
+	conflicts, err := conflictsFromNames(upload.ConflictPolicy, upload.FieldPolicies)
+	if err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+
+	mode := pb.ImportMode_IMPORT_MODE_UPSERT
+	if upload.Mode == "sync" {
+		mode = pb.ImportMode_IMPORT_MODE_SYNC
+	}
+
+	// the upload stays when the job can't be created, so finishing it can be retried
+	job, err := s.createImportJob(r.Context(), s.uploadDataPath(id), &pb.CreateImportJobRequest{
+		Mode:         mode,
+		DryRun:       upload.DryRun,
+		FileName:     upload.FileName,
+		Format:       format,
+		SlugProperty: upload.SlugProperty,
+		Loader:       loader,
+		Conflicts:    conflicts,
+	})
+	if err != nil {
+		respondGRPCError(err, w)
+		return
+	}
+
+	if err := os.Remove(s.uploadPath(id)); err != nil {
+		fmt.Printf("error removing upload %s. err: %v", id, err)
+	}
+
+	respondImportJob(job, w)
+}
+
+// DeleteUpload cancels the upload and removes its data
+func (s *PortServer) DeleteUpload(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	unlock, err := s.uploadLocks.lock(id)
+	if err != nil {
+		respondUploadError(err, w)
+		return
+	}
+	defer unlock()
+
+	if _, err := s.loadUpload(id); err != nil {
+		respondUploadError(err, w)
+		return
+	}
+
+	if err := s.removeUpload(id); err != nil {
+		respondError(err.Error(), w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ExpireUploads removes the expired uploads every uploadSweepInterval until the context is done,
+// the uploads left by the previous gateway run are kept until they expire, so they can be resumed
+func (s *PortServer) ExpireUploads(ctx context.Context) {
+	ticker := time.NewTicker(uploadSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		s.removeExpiredUploads()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// removeExpiredUploads removes the expired uploads which are not being written and the finished ones left behind
+func (s *PortServer) removeExpiredUploads() {
+	paths, err := filepath.Glob(filepath.Join(s.uploadsDir, "*.json"))
+	if err != nil {
+		fmt.Printf("error listing uploads. err: %v\n", err)
+		return
+	}
+
+	for _, path := range paths {
+		id := strings.TrimSuffix(filepath.Base(path), ".json")
+
+		unlock, err := s.uploadLocks.lock(id)
+		if err != nil {
+			continue
+		}
+
+		// loading removes the expired upload
+		if _, err := s.loadUpload(id); err != nil && err != errUploadNotFound {
+			fmt.Printf("error expiring upload %s. err: %v\n", id, err)
+		}
+		unlock()
+	}
+}
+
+// loadUpload reads the upload with its current offset, the expired upload is removed and reported as not found
+func (s *PortServer) loadUpload(id string) (Upload, error) {
+	var upload Upload
+
+	if decoded, err := hex.DecodeString(id); err != nil || len(decoded) != uploadIDSize {
+		return upload, errUploadNotFound
+	}
+
+	data, err := ioutil.ReadFile(s.uploadPath(id))
+	if os.IsNotExist(err) {
+		return upload, errUploadNotFound
+	}
+	if err != nil {
+		return upload, err
+	}
+
+	if err := json.Unmarshal(data, &upload); err != nil {
+		return upload, err
+	}
+
+	if time.Now().After(upload.ExpiresAt) {
+		if err := s.removeUpload(id); err != nil {
+			return upload, err
+		}
+
+		return upload, errUploadNotFound
+	}
+
+	info, err := os.Stat(s.uploadDataPath(id))
+	if os.IsNotExist(err) {
+		// the data is moved to the import job when the upload is finished, the upload left behind is gone
+		// even when it can't be removed yet
+		_ = os.Remove(s.uploadPath(id))
+		return upload, errUploadNotFound
+	}
+	if err != nil {
+		return upload, err
+	}
+	upload.Offset = info.Size()
+
+	return upload, nil
+}
+
+// saveUpload stores the upload next to its data, the file is replaced at once so it is never read half written
+func (s *PortServer) saveUpload(upload Upload) error {
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(s.uploadsDir, "upload-*.tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.uploadPath(upload.ID)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return nil
+}
+
+// removeUpload removes the upload with its data
+func (s *PortServer) removeUpload(id string) error {
+	if err := os.Remove(s.uploadDataPath(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.Remove(s.uploadPath(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// uploadPath is the path of the upload, its data is kept in uploadDataPath
+func (s *PortServer) uploadPath(id string) string {
+	return filepath.Join(s.uploadsDir, id+".json")
+}
+
+// uploadDataPath is the path of the data received by the upload so far
+func (s *PortServer) uploadDataPath(id string) string {
+	return filepath.Join(s.uploadsDir, id+".part")
+}
+
+func respondUpload(code int, upload Upload, w http.ResponseWriter) {
+	w.Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	if upload.Length != nil {
+		w.Header().Set("Upload-Length", strconv.FormatInt(*upload.Length, 10))
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(upload); err != nil {
+		fmt.Printf("error encoding response payload. err: %v", err)
+	}
+}
+
+func respondUploadError(err error, w http.ResponseWriter) {
+	switch err {
+	case errUploadNotFound:
+		respondStatus(http.StatusNotFound, err.Error(), w)
+	case errUploadBusy:
+		respondStatus(http.StatusConflict, err.Error(), w)
+	default:
+		respondError(err.Error(), w)
+	}
+}
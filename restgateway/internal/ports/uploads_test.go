@@ -0,0 +1,239 @@
+package ports
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/kreyyser/transshipment/pb/portentries"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+// createUpload starts the upload of the file of the given length, no length when it is empty
+func createUpload(t *testing.T, srv *PortServer, length string) Upload {
+	r := require.New(t)
+
+	req := newRequest(http.MethodPost, "/uploads?file_name=ports.json&mode=sync", "", "")
+	if length != "" {
+		req.Header.Set("Upload-Length", length)
+	}
+
+	rec := route(srv, req)
+	r.Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	var upload Upload
+	r.NoError(json.Unmarshal(rec.Body.Bytes(), &upload))
+	r.Equal("/uploads/"+upload.ID, rec.Header().Get("Location"))
+
+	return upload
+}
+
+// appendUpload sends the chunk of the upload at the offset
+func appendUpload(srv *PortServer, id, offset, chunk string) *http.Response {
+	req := newRequest(http.MethodPatch, "/uploads/"+id, "application/offset+octet-stream", chunk)
+	req.Header.Set("Upload-Offset", offset)
+
+	return route(srv, req).Result()
+}
+
+func TestPortServer_Upload(t *testing.T) {
+	r := require.New(t)
+	const file = `{"NLRTM": {"name": "Rotterdam"}}`
+
+	var created *pb.CreateImportJobRequest
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	srv := newTestServer(t, &portsClientMock{
+		createImportJob: func(req *pb.CreateImportJobRequest) (*pb.ImportJobResponse, error) {
+			created = req
+			return &pb.ImportJobResponse{Data: &pb.ImportJob{Id: 7, Mode: req.Mode, FileName: req.FileName}}, nil
+		},
+		// the gateway is shutting down, so the job is left for the next run
+		updateImportJob: func(req *pb.UpdateImportJobRequest) (*pb.ImportJobResponse, error) {
+			return nil, context.Canceled
+		},
+	})
+	srv.jobsCtx = ctx
+
+	upload := createUpload(t, srv, "32")
+	r.Equal("ports.json", upload.FileName)
+	r.Equal("sync", upload.Mode)
+	r.Equal(int64(32), *upload.Length)
+
+	res := appendUpload(srv, upload.ID, "0", file[:10])
+	r.Equal(http.StatusNoContent, res.StatusCode)
+	r.Equal("10", res.Header.Get("Upload-Offset"))
+
+	res = route(srv, newRequest(http.MethodHead, "/uploads/"+upload.ID, "", "")).Result()
+	r.Equal(http.StatusOK, res.StatusCode)
+	r.Equal("10", res.Header.Get("Upload-Offset"))
+	r.Equal("32", res.Header.Get("Upload-Length"))
+
+	res = route(srv, newRequest(http.MethodPost, "/uploads/"+upload.ID+"/finish", "", "")).Result()
+	r.Equal(http.StatusConflict, res.StatusCode)
+	r.Equal("10", res.Header.Get("Upload-Offset"))
+	r.Nil(created)
+
+	res = appendUpload(srv, upload.ID, "10", file[10:]+"\n\n")
+	r.Equal(http.StatusRequestEntityTooLarge, res.StatusCode)
+	r.Equal("32", res.Header.Get("Upload-Offset"))
+
+	res = route(srv, newRequest(http.MethodPost, "/uploads/"+upload.ID+"/finish", "", "")).Result()
+	r.Equal(http.StatusAccepted, res.StatusCode)
+	r.Equal("/imports/7", res.Header.Get("Location"))
+	r.True(proto.Equal(&pb.CreateImportJobRequest{
+		Mode:      pb.ImportMode_IMPORT_MODE_SYNC,
+		FileName:  "ports.json",
+		Conflicts: &pb.Conflicts{},
+	}, created), created.String())
+	srv.WaitImportJobs()
+
+	data, err := ioutil.ReadFile(srv.spoolPath(7))
+	r.NoError(err)
+	r.Equal(file, string(data))
+
+	res = route(srv, newRequest(http.MethodGet, "/uploads/"+upload.ID, "", "")).Result()
+	r.Equal(http.StatusNotFound, res.StatusCode)
+	r.NoFileExists(srv.uploadPath(upload.ID))
+}
+
+func TestPortServer_AppendUpload(t *testing.T) {
+	cases := []struct {
+		name   string
+		length string
+		offset string
+		chunk  string
+		code   int
+		result string
+	}{
+		{name: "unknown length", offset: "4", chunk: "5678", code: http.StatusNoContent, result: "12345678"},
+		{name: "rest of the file", length: "8", offset: "4", chunk: "5678", code: http.StatusNoContent, result: "12345678"},
+		{name: "offset behind", length: "8", offset: "2", chunk: "345678", code: http.StatusConflict, result: "1234"},
+		{name: "offset ahead", length: "8", offset: "6", chunk: "78", code: http.StatusConflict, result: "1234"},
+		{name: "wrong offset", length: "8", offset: "four", chunk: "5678", code: http.StatusBadRequest, result: "1234"},
+		{name: "longer than the file", length: "6", offset: "4", chunk: "5678", code: http.StatusRequestEntityTooLarge, result: "123456"},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			srv := newTestServer(t, &portsClientMock{})
+
+			upload := createUpload(t, srv, test.length)
+			r.Equal(http.StatusNoContent, appendUpload(srv, upload.ID, "0", "1234").StatusCode)
+
+			res := appendUpload(srv, upload.ID, test.offset, test.chunk)
+			r.Equal(test.code, res.StatusCode)
+
+			data, err := ioutil.ReadFile(srv.uploadDataPath(upload.ID))
+			r.NoError(err)
+			r.Equal(test.result, string(data))
+
+			if test.code != http.StatusBadRequest {
+				r.Equal(string(rune('0'+len(test.result))), res.Header.Get("Upload-Offset"))
+			}
+		})
+	}
+}
+
+func TestPortServer_UploadNotFound(t *testing.T) {
+	srv := newTestServer(t, &portsClientMock{})
+	upload := createUpload(t, srv, "")
+	require.NoError(t, os.Remove(srv.uploadPath(upload.ID)))
+
+	cases := []struct {
+		name string
+		req  *http.Request
+	}{
+		{name: "get", req: newRequest(http.MethodGet, "/uploads/"+upload.ID, "", "")},
+		{name: "malformed id", req: newRequest(http.MethodGet, "/uploads/not-an-upload", "", "")},
+		{name: "finish", req: newRequest(http.MethodPost, "/uploads/"+upload.ID+"/finish", "", "")},
+		{name: "delete", req: newRequest(http.MethodDelete, "/uploads/"+upload.ID, "", "")},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, http.StatusNotFound, route(srv, test.req).Code)
+		})
+	}
+}
+
+func TestPortServer_DeleteUpload(t *testing.T) {
+	r := require.New(t)
+	srv := newTestServer(t, &portsClientMock{})
+	upload := createUpload(t, srv, "")
+
+	r.Equal(http.StatusNoContent, route(srv, newRequest(http.MethodDelete, "/uploads/"+upload.ID, "", "")).Code)
+	r.NoFileExists(srv.uploadPath(upload.ID))
+	r.NoFileExists(srv.uploadDataPath(upload.ID))
+}
+
+func TestPortServer_RemoveExpiredUploads(t *testing.T) {
+	cases := []struct {
+		name    string
+		prepare func(t *testing.T, srv *PortServer, upload Upload) func()
+		kept    bool
+	}{
+		{
+			name: "active",
+			kept: true,
+		},
+		{
+			name: "expired",
+			prepare: func(t *testing.T, srv *PortServer, upload Upload) func() {
+				upload.ExpiresAt = time.Now().Add(-time.Minute)
+				require.NoError(t, srv.saveUpload(upload))
+				return func() {}
+			},
+		},
+		{
+			name: "expired being written",
+			prepare: func(t *testing.T, srv *PortServer, upload Upload) func() {
+				upload.ExpiresAt = time.Now().Add(-time.Minute)
+				require.NoError(t, srv.saveUpload(upload))
+				unlock, err := srv.uploadLocks.lock(upload.ID)
+				require.NoError(t, err)
+				return unlock
+			},
+			kept: true,
+		},
+		{
+			// the data is moved to the import job but the upload is not removed after it
+			name: "finished",
+			prepare: func(t *testing.T, srv *PortServer, upload Upload) func() {
+				require.NoError(t, os.Rename(srv.uploadDataPath(upload.ID), srv.spoolPath(7)))
+				return func() {}
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			r := require.New(t)
+			srv := newTestServer(t, &portsClientMock{})
+			upload := createUpload(t, srv, "")
+
+			if test.prepare != nil {
+				defer test.prepare(t, srv, upload)()
+			}
+
+			srv.removeExpiredUploads()
+
+			if test.kept {
+				r.FileExists(srv.uploadPath(upload.ID))
+				r.FileExists(srv.uploadDataPath(upload.ID))
+				return
+			}
+
+			r.NoFileExists(srv.uploadPath(upload.ID))
+			r.NoFileExists(srv.uploadDataPath(upload.ID))
+			_, err := srv.loadUpload(upload.ID)
+			r.Equal(errUploadNotFound, err)
+		})
+	}
+}